@@ -1,6 +1,8 @@
 package lraccount
 
 import (
+	"context"
+
 	"github.com/LoginRadius/go-sdk/httprutils"
 )
 
@@ -10,11 +12,18 @@ import (
 
 // Required template variable: uid
 func (lr Loginradius) DeleteManageAccount(uid string) (*httprutils.Response, error) {
+	return lr.DeleteManageAccountWithContext(context.Background(), uid)
+}
+
+// DeleteManageAccountWithContext is the same as DeleteManageAccount with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteManageAccountWithContext(ctx context.Context, uid string) (*httprutils.Response, error) {
 	request := lr.Client.NewDeleteReq("/identity/v2/manage/account/")
 	lr.Client.AddApiCredentialsToReqHeader(request)
 	request.URL = request.URL + uid
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.DeleteManageAccount", PathTemplate: "/identity/v2/manage/account/{uid}"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -26,19 +35,26 @@ func (lr Loginradius) DeleteManageAccount(uid string) (*httprutils.Response, err
 
 // Required body parameter: email
 func (lr Loginradius) DeleteManageAccountEmail(uid string, body interface{}) (*httprutils.Response, error) {
+	return lr.DeleteManageAccountEmailWithContext(context.Background(), uid, body)
+}
+
+// DeleteManageAccountEmailWithContext is the same as DeleteManageAccountEmail with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteManageAccountEmailWithContext(ctx context.Context, uid string, body interface{}) (*httprutils.Response, error) {
 	encoded, err := httprutils.EncodeBody(body)
 	if err != nil {
 		return nil, err
 	}
 	request := httprutils.Request{
 		Method: httprutils.Delete,
-		URL:    lr.Client.Domain + "/identity/v2/manage/account/" + uid + "/email",
+		URL:    lr.Client.EndpointURL("/identity/v2/manage/account/" + uid + "/email"),
 		Headers: map[string]string{
 			"content-Type": "application/json",
 		},
 		Body: encoded,
 	}
 	lr.Client.AddApiCredentialsToReqHeader(&request)
-	response, err := lr.Client.HTTPRClient.Send(request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.DeleteManageAccountEmail", PathTemplate: "/identity/v2/manage/account/{uid}/email"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, request)
 	return response, err
 }
@@ -1,8 +1,11 @@
 package lraccount
 
 import (
+	"context"
+
 	"github.com/LoginRadius/go-sdk/httprutils"
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
+	"github.com/LoginRadius/go-sdk/lrjson"
 )
 
 // GetManageAccountProfilesByEmail is used to retrieve all of the profile data,
@@ -13,6 +16,12 @@ import (
 
 // Required query parameter: email
 func (lr Loginradius) GetManageAccountProfilesByEmail(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetManageAccountProfilesByEmailWithContext(context.Background(), queries)
+}
+
+// GetManageAccountProfilesByEmailWithContext is the same as GetManageAccountProfilesByEmail with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetManageAccountProfilesByEmailWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"email": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -20,10 +29,17 @@ func (lr Loginradius) GetManageAccountProfilesByEmail(queries interface{}) (*htt
 	}
 	request := lr.Client.NewGetReq("/identity/v2/manage/account", validatedQueries)
 	lr.Client.AddApiCredentialsToReqHeader(request)
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.GetManageAccountProfilesByEmail", PathTemplate: "/identity/v2/manage/account"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
+// GetManageAccountProfilesByEmailTyped is the same as GetManageAccountProfilesByEmailWithContext, returning the response decoded
+// into an lrjson.Profile.
+func (lr Loginradius) GetManageAccountProfilesByEmailTyped(ctx context.Context, queries interface{}) (*lrjson.Profile, error) {
+	return lrjson.Decode[lrjson.Profile](lr.GetManageAccountProfilesByEmailWithContext(ctx, queries))
+}
+
 // GetManageAccountProfilesByUsername is used to retrieve all of the profile data,
 // associated with the specified account by username in Cloud Storage.
 // This end point returns a single profile
@@ -32,6 +48,12 @@ func (lr Loginradius) GetManageAccountProfilesByEmail(queries interface{}) (*htt
 
 // Required query parameter: username
 func (lr Loginradius) GetManageAccountProfilesByUsername(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetManageAccountProfilesByUsernameWithContext(context.Background(), queries)
+}
+
+// GetManageAccountProfilesByUsernameWithContext is the same as GetManageAccountProfilesByUsername with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetManageAccountProfilesByUsernameWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"username": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -39,10 +61,17 @@ func (lr Loginradius) GetManageAccountProfilesByUsername(queries interface{}) (*
 	}
 	request := lr.Client.NewGetReq("/identity/v2/manage/account", validatedQueries)
 	lr.Client.AddApiCredentialsToReqHeader(request)
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.GetManageAccountProfilesByUsername", PathTemplate: "/identity/v2/manage/account"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
+// GetManageAccountProfilesByUsernameTyped is the same as GetManageAccountProfilesByUsernameWithContext, returning the response decoded
+// into an lrjson.Profile.
+func (lr Loginradius) GetManageAccountProfilesByUsernameTyped(ctx context.Context, queries interface{}) (*lrjson.Profile, error) {
+	return lrjson.Decode[lrjson.Profile](lr.GetManageAccountProfilesByUsernameWithContext(ctx, queries))
+}
+
 // GetManageAccountProfilesByPhoneID is used to retrieve all of the profile data,
 // associated with the specified account by PhoneID in Cloud Storage.
 // This end point returns a single profile
@@ -51,6 +80,12 @@ func (lr Loginradius) GetManageAccountProfilesByUsername(queries interface{}) (*
 
 // Required query param: phone
 func (lr Loginradius) GetManageAccountProfilesByPhoneID(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetManageAccountProfilesByPhoneIDWithContext(context.Background(), queries)
+}
+
+// GetManageAccountProfilesByPhoneIDWithContext is the same as GetManageAccountProfilesByPhoneID with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetManageAccountProfilesByPhoneIDWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"phone": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -58,10 +93,17 @@ func (lr Loginradius) GetManageAccountProfilesByPhoneID(queries interface{}) (*h
 	}
 	request := lr.Client.NewGetReq("/identity/v2/manage/account", validatedQueries)
 	lr.Client.AddApiCredentialsToReqHeader(request)
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.GetManageAccountProfilesByPhoneID", PathTemplate: "/identity/v2/manage/account"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
+// GetManageAccountProfilesByPhoneIDTyped is the same as GetManageAccountProfilesByPhoneIDWithContext, returning the response decoded
+// into an lrjson.Profile.
+func (lr Loginradius) GetManageAccountProfilesByPhoneIDTyped(ctx context.Context, queries interface{}) (*lrjson.Profile, error) {
+	return lrjson.Decode[lrjson.Profile](lr.GetManageAccountProfilesByPhoneIDWithContext(ctx, queries))
+}
+
 // GetManageAccountProfilesByUid is used to retrieve all of the profile data,
 // associated with the specified account by uid in Cloud Storage.
 // This end point returns a single profile
@@ -70,26 +112,43 @@ func (lr Loginradius) GetManageAccountProfilesByPhoneID(queries interface{}) (*h
 
 // Required template param: uid - string representing uid
 func (lr Loginradius) GetManageAccountProfilesByUid(uid string) (*httprutils.Response, error) {
+	return lr.GetManageAccountProfilesByUidWithContext(context.Background(), uid)
+}
+
+// GetManageAccountProfilesByUidWithContext is the same as GetManageAccountProfilesByUid with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetManageAccountProfilesByUidWithContext(ctx context.Context, uid string) (*httprutils.Response, error) {
 	request := lr.Client.NewGetReq("/identity/v2/manage/account/" + uid)
 	lr.Client.AddApiCredentialsToReqHeader(request)
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.GetManageAccountProfilesByUid", PathTemplate: "/identity/v2/manage/account/{uid}"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
+// GetManageAccountProfilesByUidTyped is the same as GetManageAccountProfilesByUidWithContext, returning the response decoded
+// into an lrjson.Profile.
+func (lr Loginradius) GetManageAccountProfilesByUidTyped(ctx context.Context, uid string) (*lrjson.Profile, error) {
+	return lrjson.Decode[lrjson.Profile](lr.GetManageAccountProfilesByUidWithContext(ctx, uid))
+}
+
 // GetManageAccountIdentitiesByEmail is used to retrieve all of the identities (UID and Profiles),
 // associated with a specified email in Cloud Storage.
 // Note: This is intended for specific workflows where an email may be associated to multiple UIDs.
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/account/account-identities-by-email
 
-// This end point returns data in an array, the response needs to be handled like so:
-// 						body, _ := lrjson.DynamicUnmarshal(response.Body) // unmarshals body
-// 						profiles := body["Data"].([]interface{}) // type assertion
-// 						profile := profiles[0].(map[string]interface{}) // get first profile
-// 						uid := profile["Uid"].(string) // get id of first profile
+// This end point returns data in an array, the response can be decoded like so:
+// 						identities, _ := lrjson.DecodeAs[lrjson.Identities](response) // decodes body
+// 						uid := identities.Data[0].UID // get id of first profile
 
 // Required query param: email - string
 func (lr Loginradius) GetManageAccountIdentitiesByEmail(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetManageAccountIdentitiesByEmailWithContext(context.Background(), queries)
+}
+
+// GetManageAccountIdentitiesByEmailWithContext is the same as GetManageAccountIdentitiesByEmail with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetManageAccountIdentitiesByEmailWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"email": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -97,16 +156,29 @@ func (lr Loginradius) GetManageAccountIdentitiesByEmail(queries interface{}) (*h
 	}
 	request := lr.Client.NewGetReq("/identity/v2/manage/account/identities", validatedQueries)
 	lr.Client.AddApiCredentialsToReqHeader(request)
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.GetManageAccountIdentitiesByEmail", PathTemplate: "/identity/v2/manage/account/identities"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
+// GetManageAccountIdentitiesByEmailTyped is the same as GetManageAccountIdentitiesByEmailWithContext, returning the response decoded
+// into an lrjson.Identities.
+func (lr Loginradius) GetManageAccountIdentitiesByEmailTyped(ctx context.Context, queries interface{}) (*lrjson.Identities, error) {
+	return lrjson.Decode[lrjson.Identities](lr.GetManageAccountIdentitiesByEmailWithContext(ctx, queries))
+}
+
 // GetManageAccessTokenUID is used to get LoginRadius access token based on UID.
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/account/account-impersonation-api
 
 // Required query params: uid
 func (lr Loginradius) GetManageAccessTokenUID(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetManageAccessTokenUIDWithContext(context.Background(), queries)
+}
+
+// GetManageAccessTokenUIDWithContext is the same as GetManageAccessTokenUID with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetManageAccessTokenUIDWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"uid": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -114,7 +186,8 @@ func (lr Loginradius) GetManageAccessTokenUID(queries interface{}) (*httprutils.
 	}
 	request := lr.Client.NewGetReq("/identity/v2/manage/account/access_token", validatedQueries)
 	lr.Client.AddApiCredentialsToReqHeader(request)
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.GetManageAccessTokenUID", PathTemplate: "/identity/v2/manage/account/access_token"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -124,9 +197,16 @@ func (lr Loginradius) GetManageAccessTokenUID(queries interface{}) (*httprutils.
 
 // Required template parameter: string representing uid
 func (lr Loginradius) GetManageAccountPassword(uid string) (*httprutils.Response, error) {
+	return lr.GetManageAccountPasswordWithContext(context.Background(), uid)
+}
+
+// GetManageAccountPasswordWithContext is the same as GetManageAccountPassword with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetManageAccountPasswordWithContext(ctx context.Context, uid string) (*httprutils.Response, error) {
 	request := lr.Client.NewGetReq("/identity/v2/manage/account/" + uid + "/password")
 	lr.Client.AddApiCredentialsToReqHeader(request)
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.GetManageAccountPassword", PathTemplate: "/identity/v2/manage/account/{uid}/password"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -137,6 +217,12 @@ func (lr Loginradius) GetManageAccountPassword(uid string) (*httprutils.Response
 // Required template parameter: string representing refreshToken
 
 func (lr Loginradius) GetRefreshAccessTokenByRefreshToken(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetRefreshAccessTokenByRefreshTokenWithContext(context.Background(), queries)
+}
+
+// GetRefreshAccessTokenByRefreshTokenWithContext is the same as GetRefreshAccessTokenByRefreshToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetRefreshAccessTokenByRefreshTokenWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"refresh_token": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -144,7 +230,8 @@ func (lr Loginradius) GetRefreshAccessTokenByRefreshToken(queries interface{}) (
 	}
 	request := lr.Client.NewGetReq("/identity/v2/manage/account/access_token/refresh", validatedQueries)
 	lr.Client.AddApiCredentialsToReqHeader(request)
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.GetRefreshAccessTokenByRefreshToken", PathTemplate: "/identity/v2/manage/account/access_token/refresh"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -155,6 +242,12 @@ func (lr Loginradius) GetRefreshAccessTokenByRefreshToken(queries interface{}) (
 // Required template parameter: string representing refreshToken
 
 func (lr Loginradius) GetRevokeRefreshToken(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetRevokeRefreshTokenWithContext(context.Background(), queries)
+}
+
+// GetRevokeRefreshTokenWithContext is the same as GetRevokeRefreshToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetRevokeRefreshTokenWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"refresh_token": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -162,7 +255,7 @@ func (lr Loginradius) GetRevokeRefreshToken(queries interface{}) (*httprutils.Re
 	}
 	request := lr.Client.NewGetReq("/identity/v2/manage/account/access_token/refresh/revoke", validatedQueries)
 	lr.Client.AddApiCredentialsToReqHeader(request)
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.GetRevokeRefreshToken", PathTemplate: "/identity/v2/manage/account/access_token/refresh/revoke"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
-
@@ -1,8 +1,11 @@
 package lraccount
 
 import (
+	"context"
+
 	"github.com/LoginRadius/go-sdk/httprutils"
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
+	"github.com/LoginRadius/go-sdk/lrjson"
 )
 
 // PostManageAccountCreate is used to create an account in Cloud Storage.
@@ -19,6 +22,12 @@ import (
 // Pass data in struct lrbody.AccountCreate as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte or map[string]string{} could also be passed as body
 func (lr Loginradius) PostManageAccountCreate(body interface{}) (*httprutils.Response, error) {
+	return lr.PostManageAccountCreateWithContext(context.Background(), body)
+}
+
+// PostManageAccountCreateWithContext is the same as PostManageAccountCreate with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostManageAccountCreateWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPostReq("/identity/v2/manage/account", body)
 	if err != nil {
 		return nil, err
@@ -26,10 +35,17 @@ func (lr Loginradius) PostManageAccountCreate(body interface{}) (*httprutils.Res
 
 	lr.Client.AddApiCredentialsToReqHeader(request)
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.PostManageAccountCreate", PathTemplate: "/identity/v2/manage/account"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
+// PostManageAccountCreateTyped is the same as PostManageAccountCreateWithContext, returning the response decoded
+// into an lrjson.Profile.
+func (lr Loginradius) PostManageAccountCreateTyped(ctx context.Context, body interface{}) (*lrjson.Profile, error) {
+	return lrjson.Decode[lrjson.Profile](lr.PostManageAccountCreateWithContext(ctx, body))
+}
+
 // PostManageForgotPasswordToken returns a forgot password token. Note: If you have the
 // UserName workflow enabled, you may replace the 'email' parameter with 'username'.
 
@@ -42,6 +58,12 @@ func (lr Loginradius) PostManageAccountCreate(body interface{}) (*httprutils.Res
 // Pass data in struct lrbody.Username or lrbody.Email as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte or map[string]string{} could also be passed as body
 func (lr Loginradius) PostManageForgotPasswordToken(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PostManageForgotPasswordTokenWithContext(context.Background(), body, queries...)
+}
+
+// PostManageForgotPasswordTokenWithContext is the same as PostManageForgotPasswordToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostManageForgotPasswordTokenWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPostReq("/identity/v2/manage/account/forgot/token", body)
 	if err != nil {
 		return nil, err
@@ -63,7 +85,8 @@ func (lr Loginradius) PostManageForgotPasswordToken(body interface{}, queries ..
 		}
 	}
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.PostManageForgotPasswordToken", PathTemplate: "/identity/v2/manage/account/forgot/token"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -76,11 +99,18 @@ func (lr Loginradius) PostManageForgotPasswordToken(body interface{}, queries ..
 // Pass data in struct lrbody.EmailForVToken as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte or map[string]string{} could also be passed as body
 func (lr Loginradius) PostManageEmailVerificationToken(body interface{}) (*httprutils.Response, error) {
+	return lr.PostManageEmailVerificationTokenWithContext(context.Background(), body)
+}
+
+// PostManageEmailVerificationTokenWithContext is the same as PostManageEmailVerificationToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostManageEmailVerificationTokenWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPostReq("/identity/v2/manage/account/verify/token", body)
 	if err != nil {
 		return nil, err
 	}
 	lr.Client.AddApiCredentialsToReqHeader(request)
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.PostManageEmailVerificationToken", PathTemplate: "/identity/v2/manage/account/verify/token"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
@@ -1,6 +1,8 @@
 package lraccount
 
 import (
+	"context"
+
 	"github.com/LoginRadius/go-sdk/httprutils"
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
 )
@@ -18,6 +20,12 @@ import (
 // Pass data in struct lrbody.AccountSecurityQuestion as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte could also be passed as body
 func (lr Loginradius) PutManageAccountUpdateSecurityQuestionConfig(uid string, body interface{}) (*httprutils.Response, error) {
+	return lr.PutManageAccountUpdateSecurityQuestionConfigWithContext(context.Background(), uid, body)
+}
+
+// PutManageAccountUpdateSecurityQuestionConfigWithContext is the same as PutManageAccountUpdateSecurityQuestionConfig with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutManageAccountUpdateSecurityQuestionConfigWithContext(ctx context.Context, uid string, body interface{}) (*httprutils.Response, error) {
 
 	request, err := lr.Client.NewPutReq("/identity/v2/manage/account/"+uid, body)
 	if err != nil {
@@ -25,7 +33,8 @@ func (lr Loginradius) PutManageAccountUpdateSecurityQuestionConfig(uid string, b
 	}
 	lr.Client.AddApiCredentialsToReqHeader(request)
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.PutManageAccountUpdateSecurityQuestionConfig", PathTemplate: "/identity/v2/manage/account/{uid}"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -40,6 +49,12 @@ func (lr Loginradius) PutManageAccountUpdateSecurityQuestionConfig(uid string, b
 // Pass data in struct lrbody.AccountPassword as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte could also be passed as body
 func (lr Loginradius) PutManageAccountSetPassword(uid string, body interface{}) (*httprutils.Response, error) {
+	return lr.PutManageAccountSetPasswordWithContext(context.Background(), uid, body)
+}
+
+// PutManageAccountSetPasswordWithContext is the same as PutManageAccountSetPassword with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutManageAccountSetPasswordWithContext(ctx context.Context, uid string, body interface{}) (*httprutils.Response, error) {
 
 	request, err := lr.Client.NewPutReq("/identity/v2/manage/account/"+uid+"/password", body)
 	if err != nil {
@@ -47,7 +62,8 @@ func (lr Loginradius) PutManageAccountSetPassword(uid string, body interface{})
 	}
 	lr.Client.AddApiCredentialsToReqHeader(request)
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.PutManageAccountSetPassword", PathTemplate: "/identity/v2/manage/account/{uid}/password"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -63,13 +79,20 @@ func (lr Loginradius) PutManageAccountSetPassword(uid string, body interface{})
 // Pass data in struct lrbody.UpdateProfile as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte could also be passed as body
 func (lr Loginradius) PutManageAccountUpdate(uid string, body interface{}) (*httprutils.Response, error) {
+	return lr.PutManageAccountUpdateWithContext(context.Background(), uid, body)
+}
+
+// PutManageAccountUpdateWithContext is the same as PutManageAccountUpdate with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutManageAccountUpdateWithContext(ctx context.Context, uid string, body interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPutReq("/identity/v2/manage/account/"+uid, body)
 	if err != nil {
 		return nil, err
 	}
 	lr.Client.AddApiCredentialsToReqHeader(request)
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.PutManageAccountUpdate", PathTemplate: "/identity/v2/manage/account/{uid}"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -79,6 +102,12 @@ func (lr Loginradius) PutManageAccountUpdate(uid string, body interface{}) (*htt
 
 // Optional query params: verificationurl,  emailtemplate
 func (lr Loginradius) PutManageAccountInvalidateVerificationEmail(uid string, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PutManageAccountInvalidateVerificationEmailWithContext(context.Background(), uid, queries...)
+}
+
+// PutManageAccountInvalidateVerificationEmailWithContext is the same as PutManageAccountInvalidateVerificationEmail with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutManageAccountInvalidateVerificationEmailWithContext(ctx context.Context, uid string, queries ...interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPutReq("/identity/v2/manage/account/"+uid+"/invalidateemail", "")
 	if err != nil {
 		return nil, err
@@ -99,6 +128,7 @@ func (lr Loginradius) PutManageAccountInvalidateVerificationEmail(uid string, qu
 	}
 
 	lr.Client.AddApiCredentialsToReqHeader(request)
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lraccount.PutManageAccountInvalidateVerificationEmail", PathTemplate: "/identity/v2/manage/account/{uid}/invalidateemail"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
@@ -1,6 +1,8 @@
 package lrauthentication
 
 import (
+	"context"
+
 	"github.com/LoginRadius/go-sdk/httprutils"
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
 )
@@ -13,6 +15,12 @@ import (
 
 // Optional query params: deleteurl, emailtemplate
 func (lr Loginradius) DeleteAuthDeleteAccountEmailConfirmation(queries ...interface{}) (*httprutils.Response, error) {
+	return lr.DeleteAuthDeleteAccountEmailConfirmationWithContext(context.Background(), queries...)
+}
+
+// DeleteAuthDeleteAccountEmailConfirmationWithContext is the same as DeleteAuthDeleteAccountEmailConfirmation with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteAuthDeleteAccountEmailConfirmationWithContext(ctx context.Context, queries ...interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewDeleteReqWithToken("/identity/v2/auth/account", "")
 	if err != nil {
 		return nil, err
@@ -31,7 +39,8 @@ func (lr Loginradius) DeleteAuthDeleteAccountEmailConfirmation(queries ...interf
 			request.QueryParams[k] = v
 		}
 	}
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.DeleteAuthDeleteAccountEmailConfirmation", PathTemplate: "/identity/v2/auth/account"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -44,12 +53,19 @@ func (lr Loginradius) DeleteAuthDeleteAccountEmailConfirmation(queries ...interf
 // Pass data in struct lrbody.EmailStr as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte or map[string]string{} could also be passed as body
 func (lr Loginradius) DeleteAuthRemoveEmail(body interface{}) (*httprutils.Response, error) {
+	return lr.DeleteAuthRemoveEmailWithContext(context.Background(), body)
+}
+
+// DeleteAuthRemoveEmailWithContext is the same as DeleteAuthRemoveEmail with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteAuthRemoveEmailWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 
 	request, err := lr.Client.NewDeleteReqWithToken("/identity/v2/auth/email", body)
 	if err != nil {
 		return nil, err
 	}
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.DeleteAuthRemoveEmail", PathTemplate: "/identity/v2/auth/email"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -63,11 +79,18 @@ func (lr Loginradius) DeleteAuthRemoveEmail(body interface{}) (*httprutils.Respo
 
 // Required query parameter: apiKey
 func (lr Loginradius) DeleteAuthUnlinkSocialIdentities(body interface{}) (*httprutils.Response, error) {
+	return lr.DeleteAuthUnlinkSocialIdentitiesWithContext(context.Background(), body)
+}
+
+// DeleteAuthUnlinkSocialIdentitiesWithContext is the same as DeleteAuthUnlinkSocialIdentities with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteAuthUnlinkSocialIdentitiesWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewDeleteReqWithToken("/identity/v2/auth/socialidentity", body)
 
 	if err != nil {
 		return nil, err
 	}
-	response, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.DeleteAuthUnlinkSocialIdentities", PathTemplate: "/identity/v2/auth/socialidentity"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return response, err
 }
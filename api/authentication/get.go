@@ -1,8 +1,11 @@
 package lrauthentication
 
 import (
+	"context"
+
 	"github.com/LoginRadius/go-sdk/httprutils"
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
+	"github.com/LoginRadius/go-sdk/lrjson"
 )
 
 // GetAuthVerifyEmail is used to verify the email of user.
@@ -14,6 +17,12 @@ import (
 
 // Required query parameters: apiKey, verificationtoken;  Optional query parameter: url
 func (lr Loginradius) GetAuthVerifyEmail(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetAuthVerifyEmailWithContext(context.Background(), queries)
+}
+
+// GetAuthVerifyEmailWithContext is the same as GetAuthVerifyEmail with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAuthVerifyEmailWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"url": true, "verificationtoken": true,
 	}
@@ -24,7 +33,8 @@ func (lr Loginradius) GetAuthVerifyEmail(queries interface{}) (*httprutils.Respo
 	validatedQueries["apiKey"] = lr.Client.Context.ApiKey
 
 	req := lr.Client.NewGetReq("/identity/v2/auth/email", validatedQueries)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetAuthVerifyEmail", PathTemplate: "/identity/v2/auth/email"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -34,6 +44,12 @@ func (lr Loginradius) GetAuthVerifyEmail(queries interface{}) (*httprutils.Respo
 
 // Required query parameters: apiKey, email
 func (lr Loginradius) GetAuthCheckEmailAvailability(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetAuthCheckEmailAvailabilityWithContext(context.Background(), queries)
+}
+
+// GetAuthCheckEmailAvailabilityWithContext is the same as GetAuthCheckEmailAvailability with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAuthCheckEmailAvailabilityWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"email": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -42,7 +58,8 @@ func (lr Loginradius) GetAuthCheckEmailAvailability(queries interface{}) (*httpr
 	validatedQueries["apiKey"] = lr.Client.Context.ApiKey
 
 	req := lr.Client.NewGetReq("/identity/v2/auth/email", validatedQueries)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetAuthCheckEmailAvailability", PathTemplate: "/identity/v2/auth/email"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -53,6 +70,12 @@ func (lr Loginradius) GetAuthCheckEmailAvailability(queries interface{}) (*httpr
 
 // Required query parameters: apiKey, username
 func (lr Loginradius) GetAuthCheckUsernameAvailability(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetAuthCheckUsernameAvailabilityWithContext(context.Background(), queries)
+}
+
+// GetAuthCheckUsernameAvailabilityWithContext is the same as GetAuthCheckUsernameAvailability with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAuthCheckUsernameAvailabilityWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"username": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -61,7 +84,8 @@ func (lr Loginradius) GetAuthCheckUsernameAvailability(queries interface{}) (*ht
 	validatedQueries["apiKey"] = lr.Client.Context.ApiKey
 
 	req := lr.Client.NewGetReq("/identity/v2/auth/username", validatedQueries)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetAuthCheckUsernameAvailability", PathTemplate: "/identity/v2/auth/username"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -71,23 +95,43 @@ func (lr Loginradius) GetAuthCheckUsernameAvailability(queries interface{}) (*ht
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/authentication/auth-read-profiles-by-token
 func (lr Loginradius) GetAuthReadProfilesByToken() (*httprutils.Response, error) {
+	return lr.GetAuthReadProfilesByTokenWithContext(context.Background())
+}
+
+// GetAuthReadProfilesByTokenWithContext is the same as GetAuthReadProfilesByToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAuthReadProfilesByTokenWithContext(ctx context.Context) (*httprutils.Response, error) {
 	req, err := lr.Client.NewGetReqWithToken("/identity/v2/auth/account")
 	if err != nil {
 		return nil, err
 	}
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetAuthReadProfilesByToken", PathTemplate: "/identity/v2/auth/account"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
+// GetAuthReadProfilesByTokenTyped is the same as GetAuthReadProfilesByTokenWithContext, returning the response decoded
+// into an lrjson.Profile.
+func (lr Loginradius) GetAuthReadProfilesByTokenTyped(ctx context.Context) (*lrjson.Profile, error) {
+	return lrjson.Decode[lrjson.Profile](lr.GetAuthReadProfilesByTokenWithContext(ctx))
+}
+
 // GetAuthPrivatePolicyAccept is used update the privacy policy stored in the user's profile based on user's access token
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/authentication/auth-privacy-policy-accept
 func (lr Loginradius) GetAuthPrivatePolicyAccept() (*httprutils.Response, error) {
+	return lr.GetAuthPrivatePolicyAcceptWithContext(context.Background())
+}
+
+// GetAuthPrivatePolicyAcceptWithContext is the same as GetAuthPrivatePolicyAccept with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAuthPrivatePolicyAcceptWithContext(ctx context.Context) (*httprutils.Response, error) {
 	req, err := lr.Client.NewGetReqWithToken("/identity/v2/auth/privacypolicy/accept")
 	if err != nil {
 		return nil, err
 	}
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetAuthPrivatePolicyAccept", PathTemplate: "/identity/v2/auth/privacypolicy/accept"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -95,6 +139,12 @@ func (lr Loginradius) GetAuthPrivatePolicyAccept() (*httprutils.Response, error)
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/authentication/auth-send-welcome-email
 func (lr Loginradius) GetAuthSendWelcomeEmail(queries ...interface{}) (*httprutils.Response, error) {
+	return lr.GetAuthSendWelcomeEmailWithContext(context.Background(), queries...)
+}
+
+// GetAuthSendWelcomeEmailWithContext is the same as GetAuthSendWelcomeEmail with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAuthSendWelcomeEmailWithContext(ctx context.Context, queries ...interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewGetReqWithToken("/identity/v2/auth/account/sendwelcomeemail")
 	if err != nil {
 		return nil, err
@@ -112,7 +162,8 @@ func (lr Loginradius) GetAuthSendWelcomeEmail(queries ...interface{}) (*httpruti
 		}
 	}
 
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetAuthSendWelcomeEmail", PathTemplate: "/identity/v2/auth/account/sendwelcomeemail"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -123,11 +174,18 @@ func (lr Loginradius) GetAuthSendWelcomeEmail(queries ...interface{}) (*httpruti
 
 // Required query parameters: apiKey
 func (lr Loginradius) GetAuthSocialIdentity() (*httprutils.Response, error) {
+	return lr.GetAuthSocialIdentityWithContext(context.Background())
+}
+
+// GetAuthSocialIdentityWithContext is the same as GetAuthSocialIdentity with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAuthSocialIdentityWithContext(ctx context.Context) (*httprutils.Response, error) {
 	req, err := lr.Client.NewGetReqWithToken("/identity/v2/auth/socialidentity")
 	if err != nil {
 		return nil, err
 	}
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetAuthSocialIdentity", PathTemplate: "/identity/v2/auth/socialidentity"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -138,20 +196,39 @@ func (lr Loginradius) GetAuthSocialIdentity() (*httprutils.Response, error) {
 
 // Required query parameters: apiKey
 func (lr Loginradius) GetAuthValidateAccessToken() (*httprutils.Response, error) {
+	return lr.GetAuthValidateAccessTokenWithContext(context.Background())
+}
+
+// GetAuthValidateAccessTokenWithContext is the same as GetAuthValidateAccessToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAuthValidateAccessTokenWithContext(ctx context.Context) (*httprutils.Response, error) {
 	req, err := lr.Client.NewGetReqWithToken("/identity/v2/auth/access_token/validate")
 	if err != nil {
 		return nil, err
 	}
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetAuthValidateAccessToken", PathTemplate: "/identity/v2/auth/access_token/validate"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
+// GetAuthValidateAccessTokenTyped is the same as GetAuthValidateAccessTokenWithContext, returning the response decoded
+// into an lrjson.AccessToken.
+func (lr Loginradius) GetAuthValidateAccessTokenTyped(ctx context.Context) (*lrjson.AccessToken, error) {
+	return lrjson.Decode[lrjson.AccessToken](lr.GetAuthValidateAccessTokenWithContext(ctx))
+}
+
 // GetAuthDeleteAccount is used to delete an account by passing it a delete token.
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/authentication/auth-delete-account
 
 // Required query parameters: apiKey, deletetoken
 func (lr Loginradius) GetAuthDeleteAccount(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetAuthDeleteAccountWithContext(context.Background(), queries)
+}
+
+// GetAuthDeleteAccountWithContext is the same as GetAuthDeleteAccount with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAuthDeleteAccountWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"deletetoken": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -159,7 +236,8 @@ func (lr Loginradius) GetAuthDeleteAccount(queries interface{}) (*httprutils.Res
 	}
 	validatedQueries["apiKey"] = lr.Client.Context.ApiKey
 	req := lr.Client.NewGetReq("/identity/v2/auth/account/delete", validatedQueries)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetAuthDeleteAccount", PathTemplate: "/identity/v2/auth/account/delete"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -169,11 +247,18 @@ func (lr Loginradius) GetAuthDeleteAccount(queries interface{}) (*httprutils.Res
 
 // Required query parameter: apiKey; optional query parameter: preventRefresh
 func (lr Loginradius) GetAuthInvalidateAccessToken() (*httprutils.Response, error) {
+	return lr.GetAuthInvalidateAccessTokenWithContext(context.Background())
+}
+
+// GetAuthInvalidateAccessTokenWithContext is the same as GetAuthInvalidateAccessToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAuthInvalidateAccessTokenWithContext(ctx context.Context) (*httprutils.Response, error) {
 	req, err := lr.Client.NewGetReqWithToken("/identity/v2/auth/access_token/invalidate")
 	if err != nil {
 		return nil, err
 	}
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetAuthInvalidateAccessToken", PathTemplate: "/identity/v2/auth/access_token/invalidate"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -185,11 +270,18 @@ func (lr Loginradius) GetAuthInvalidateAccessToken() (*httprutils.Response, erro
 
 // Required query parameters: apiKey
 func (lr Loginradius) GetAuthSecurityQuestionByAccessToken() (*httprutils.Response, error) {
+	return lr.GetAuthSecurityQuestionByAccessTokenWithContext(context.Background())
+}
+
+// GetAuthSecurityQuestionByAccessTokenWithContext is the same as GetAuthSecurityQuestionByAccessToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAuthSecurityQuestionByAccessTokenWithContext(ctx context.Context) (*httprutils.Response, error) {
 	req, err := lr.Client.NewGetReqWithToken("/identity/v2/auth/securityquestion/accesstoken")
 	if err != nil {
 		return nil, err
 	}
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetAuthSecurityQuestionByAccessToken", PathTemplate: "/identity/v2/auth/securityquestion/accesstoken"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -201,6 +293,12 @@ func (lr Loginradius) GetAuthSecurityQuestionByAccessToken() (*httprutils.Respon
 
 // Required query parameters: apiKey, email
 func (lr Loginradius) GetAuthSecurityQuestionByEmail(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetAuthSecurityQuestionByEmailWithContext(context.Background(), queries)
+}
+
+// GetAuthSecurityQuestionByEmailWithContext is the same as GetAuthSecurityQuestionByEmail with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAuthSecurityQuestionByEmailWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"email": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -211,7 +309,8 @@ func (lr Loginradius) GetAuthSecurityQuestionByEmail(queries interface{}) (*http
 	if err != nil {
 		return nil, err
 	}
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetAuthSecurityQuestionByEmail", PathTemplate: "/identity/v2/auth/securityquestion/email"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -223,6 +322,12 @@ func (lr Loginradius) GetAuthSecurityQuestionByEmail(queries interface{}) (*http
 
 // Required query parameters: apikey
 func (lr Loginradius) GetAuthSecurityQuestionByUsername(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetAuthSecurityQuestionByUsernameWithContext(context.Background(), queries)
+}
+
+// GetAuthSecurityQuestionByUsernameWithContext is the same as GetAuthSecurityQuestionByUsername with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAuthSecurityQuestionByUsernameWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"username": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -233,7 +338,8 @@ func (lr Loginradius) GetAuthSecurityQuestionByUsername(queries interface{}) (*h
 	if err != nil {
 		return nil, err
 	}
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetAuthSecurityQuestionByUsername", PathTemplate: "/identity/v2/auth/securityquestion/username"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -245,6 +351,12 @@ func (lr Loginradius) GetAuthSecurityQuestionByUsername(queries interface{}) (*h
 
 // Required query parameters: phone
 func (lr Loginradius) GetAuthSecurityQuestionByPhone(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetAuthSecurityQuestionByPhoneWithContext(context.Background(), queries)
+}
+
+// GetAuthSecurityQuestionByPhoneWithContext is the same as GetAuthSecurityQuestionByPhone with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAuthSecurityQuestionByPhoneWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"phone": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -255,7 +367,8 @@ func (lr Loginradius) GetAuthSecurityQuestionByPhone(queries interface{}) (*http
 	if err != nil {
 		return nil, err
 	}
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetAuthSecurityQuestionByPhone", PathTemplate: "/identity/v2/auth/securityquestion/phone"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -265,6 +378,12 @@ func (lr Loginradius) GetAuthSecurityQuestionByPhone(queries interface{}) (*http
 
 // Required query parameters: email, apiKey; optional queries: passwordlesslogintemplate, verificationurl
 func (lr Loginradius) GetPasswordlessLoginByEmail(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetPasswordlessLoginByEmailWithContext(context.Background(), queries)
+}
+
+// GetPasswordlessLoginByEmailWithContext is the same as GetPasswordlessLoginByEmail with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetPasswordlessLoginByEmailWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"email": true, "passwordlesslogintemplate": true, "verificationurl": true,
 	}
@@ -276,7 +395,8 @@ func (lr Loginradius) GetPasswordlessLoginByEmail(queries interface{}) (*httprut
 
 	req := lr.Client.NewGetReq("/identity/v2/auth/login/passwordlesslogin/email", validatedQueries)
 
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetPasswordlessLoginByEmail", PathTemplate: "/identity/v2/auth/login/passwordlesslogin/email"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -286,6 +406,12 @@ func (lr Loginradius) GetPasswordlessLoginByEmail(queries interface{}) (*httprut
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/passwordless-login/passwordless-login-by-username
 func (lr Loginradius) GetPasswordlessLoginByUsername(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetPasswordlessLoginByUsernameWithContext(context.Background(), queries)
+}
+
+// GetPasswordlessLoginByUsernameWithContext is the same as GetPasswordlessLoginByUsername with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetPasswordlessLoginByUsernameWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"username": true, "passwordlesslogintemplate": true, "verificationurl": true,
 	}
@@ -296,7 +422,8 @@ func (lr Loginradius) GetPasswordlessLoginByUsername(queries interface{}) (*http
 	validatedQueries["apiKey"] = lr.Client.Context.ApiKey
 	req := lr.Client.NewGetReq("/identity/v2/auth/login/passwordlesslogin/email", validatedQueries)
 
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetPasswordlessLoginByUsername", PathTemplate: "/identity/v2/auth/login/passwordlesslogin/email"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -306,6 +433,12 @@ func (lr Loginradius) GetPasswordlessLoginByUsername(queries interface{}) (*http
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/passwordless-login/passwordless-login-verification
 func (lr Loginradius) GetPasswordlessLoginVerification(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetPasswordlessLoginVerificationWithContext(context.Background(), queries)
+}
+
+// GetPasswordlessLoginVerificationWithContext is the same as GetPasswordlessLoginVerification with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetPasswordlessLoginVerificationWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"verificationtoken": true, "welcomeemailtemplate": true,
 	}
@@ -316,6 +449,7 @@ func (lr Loginradius) GetPasswordlessLoginVerification(queries interface{}) (*ht
 	validatedQueries["apiKey"] = lr.Client.Context.ApiKey
 	req := lr.Client.NewGetReq("/identity/v2/auth/login/passwordlesslogin/email/verify", validatedQueries)
 
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.GetPasswordlessLoginVerification", PathTemplate: "/identity/v2/auth/login/passwordlesslogin/email/verify"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
@@ -1,8 +1,11 @@
 package lrauthentication
 
 import (
+	"context"
+
 	"github.com/LoginRadius/go-sdk/httprutils"
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
+	"github.com/LoginRadius/go-sdk/lrjson"
 )
 
 // PostAuthAddEmail is used to add additional emails to a user's account.
@@ -15,6 +18,12 @@ import (
 
 // Required body parameters: email -string, type -string
 func (lr Loginradius) PostAuthAddEmail(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PostAuthAddEmailWithContext(context.Background(), body, queries...)
+}
+
+// PostAuthAddEmailWithContext is the same as PostAuthAddEmail with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostAuthAddEmailWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPostReqWithToken("/identity/v2/auth/email", body)
 	if err != nil {
 		return nil, err
@@ -34,7 +43,8 @@ func (lr Loginradius) PostAuthAddEmail(body interface{}, queries ...interface{})
 		}
 	}
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PostAuthAddEmail", PathTemplate: "/identity/v2/auth/email"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -50,6 +60,12 @@ func (lr Loginradius) PostAuthAddEmail(body interface{}, queries ...interface{})
 
 // Pass data in struct lrbody.EmailStr as body to help ensure parameters satisfy API requirements
 func (lr Loginradius) PostAuthForgotPassword(body interface{}, queries interface{}) (*httprutils.Response, error) {
+	return lr.PostAuthForgotPasswordWithContext(context.Background(), body, queries)
+}
+
+// PostAuthForgotPasswordWithContext is the same as PostAuthForgotPassword with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostAuthForgotPasswordWithContext(ctx context.Context, body interface{}, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"resetpasswordurl": true, "emailtemplate": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 
@@ -61,7 +77,8 @@ func (lr Loginradius) PostAuthForgotPassword(body interface{}, queries interface
 	if err != nil {
 		return nil, err
 	}
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PostAuthForgotPassword", PathTemplate: "/identity/v2/auth/password"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -73,6 +90,12 @@ func (lr Loginradius) PostAuthForgotPassword(body interface{}, queries interface
 // Required  parameter: sott
 // Pass data in struct lrbody.RegistrationUser as body to help ensure parameters satisfy API requirements
 func (lr Loginradius) PostAuthUserRegistrationByEmail(sott string, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PostAuthUserRegistrationByEmailWithContext(context.Background(), sott, body, queries...)
+}
+
+// PostAuthUserRegistrationByEmailWithContext is the same as PostAuthUserRegistrationByEmail with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostAuthUserRegistrationByEmailWithContext(ctx context.Context, sott string, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	queryParams := map[string]string{}
 	for _, arg := range queries {
 		allowedQueries := map[string]bool{
@@ -94,7 +117,8 @@ func (lr Loginradius) PostAuthUserRegistrationByEmail(sott string, body interfac
 	}
 
 	request.Headers["X-LoginRadius-Sott"] = sott
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PostAuthUserRegistrationByEmail", PathTemplate: "/identity/v2/auth/register"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -109,6 +133,12 @@ func (lr Loginradius) PostAuthUserRegistrationByEmail(sott string, body interfac
 
 // Required body parameters: email, password; optional body parameters: security answer
 func (lr Loginradius) PostAuthLoginByEmail(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PostAuthLoginByEmailWithContext(context.Background(), body, queries...)
+}
+
+// PostAuthLoginByEmailWithContext is the same as PostAuthLoginByEmail with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostAuthLoginByEmailWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPostReq("/identity/v2/auth/login", body)
 	for _, arg := range queries {
 		allowedQueries := map[string]bool{
@@ -123,10 +153,17 @@ func (lr Loginradius) PostAuthLoginByEmail(body interface{}, queries ...interfac
 			request.QueryParams[k] = v
 		}
 	}
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PostAuthLoginByEmail", PathTemplate: "/identity/v2/auth/login"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
+// PostAuthLoginByEmailTyped is the same as PostAuthLoginByEmailWithContext, returning the response decoded
+// into an lrjson.AccessToken.
+func (lr Loginradius) PostAuthLoginByEmailTyped(ctx context.Context, body interface{}, queries ...interface{}) (*lrjson.AccessToken, error) {
+	return lrjson.Decode[lrjson.AccessToken](lr.PostAuthLoginByEmailWithContext(ctx, body, queries...))
+}
+
 // PostAuthLoginByUsername retrieves a copy of the user data based on the Username after verifying
 // the validity of submitted credentials
 
@@ -136,6 +173,12 @@ func (lr Loginradius) PostAuthLoginByEmail(body interface{}, queries ...interfac
 
 // Pass data in struct lrbody.UsernameLogin as body to help ensure parameters satisfy API requirements
 func (lr Loginradius) PostAuthLoginByUsername(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PostAuthLoginByUsernameWithContext(context.Background(), body, queries...)
+}
+
+// PostAuthLoginByUsernameWithContext is the same as PostAuthLoginByUsername with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostAuthLoginByUsernameWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPostReq("/identity/v2/auth/login", body)
 	for _, arg := range queries {
 		allowedQueries := map[string]bool{
@@ -150,6 +193,13 @@ func (lr Loginradius) PostAuthLoginByUsername(body interface{}, queries ...inter
 			request.QueryParams[k] = v
 		}
 	}
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PostAuthLoginByUsername", PathTemplate: "/identity/v2/auth/login"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
+
+// PostAuthLoginByUsernameTyped is the same as PostAuthLoginByUsernameWithContext, returning the response decoded
+// into an lrjson.AccessToken.
+func (lr Loginradius) PostAuthLoginByUsernameTyped(ctx context.Context, body interface{}, queries ...interface{}) (*lrjson.AccessToken, error) {
+	return lrjson.Decode[lrjson.AccessToken](lr.PostAuthLoginByUsernameWithContext(ctx, body, queries...))
+}
@@ -1,6 +1,8 @@
 package lrauthentication
 
 import (
+	"context"
+
 	"github.com/LoginRadius/go-sdk/httprutils"
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
 )
@@ -15,6 +17,12 @@ import (
 
 // Required query parameter: apiKey; Optional query parameters: url, welcometemplate
 func (lr Loginradius) PutAuthVerifyEmailByOtp(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PutAuthVerifyEmailByOtpWithContext(context.Background(), body, queries...)
+}
+
+// PutAuthVerifyEmailByOtpWithContext is the same as PutAuthVerifyEmailByOtp with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutAuthVerifyEmailByOtpWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPutReq("/identity/v2/auth/email", body)
 	if err != nil {
 		return nil, err
@@ -34,7 +42,8 @@ func (lr Loginradius) PutAuthVerifyEmailByOtp(body interface{}, queries ...inter
 		}
 	}
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PutAuthVerifyEmailByOtp", PathTemplate: "/identity/v2/auth/email"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -49,6 +58,12 @@ func (lr Loginradius) PutAuthVerifyEmailByOtp(body interface{}, queries ...inter
 // Pass data in struct lrbody.ChangePassword as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte or map[string]string{} could also be passed as body
 func (lr Loginradius) PutAuthChangePassword(body interface{}) (*httprutils.Response, error) {
+	return lr.PutAuthChangePasswordWithContext(context.Background(), body)
+}
+
+// PutAuthChangePasswordWithContext is the same as PutAuthChangePassword with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutAuthChangePasswordWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 
 	request, err := lr.Client.NewPutReqWithToken("/identity/v2/auth/password/change", body)
 
@@ -56,7 +71,8 @@ func (lr Loginradius) PutAuthChangePassword(body interface{}) (*httprutils.Respo
 		return nil, err
 	}
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PutAuthChangePassword", PathTemplate: "/identity/v2/auth/password/change"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -69,12 +85,19 @@ func (lr Loginradius) PutAuthChangePassword(body interface{}) (*httprutils.Respo
 
 // Pass data in struct lrbody.LinkSocialIds as body to help ensure parameters satisfy API requirements
 func (lr Loginradius) PutAuthLinkSocialIdentities(token string, body interface{}) (*httprutils.Response, error) {
+	return lr.PutAuthLinkSocialIdentitiesWithContext(context.Background(), token, body)
+}
+
+// PutAuthLinkSocialIdentitiesWithContext is the same as PutAuthLinkSocialIdentities with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutAuthLinkSocialIdentitiesWithContext(ctx context.Context, token string, body interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPutReqWithToken("/identity/v2/auth/socialidentity", body)
 	if err != nil {
 		return nil, err
 	}
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PutAuthLinkSocialIdentities", PathTemplate: "/identity/v2/auth/socialidentity"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -89,6 +112,12 @@ func (lr Loginradius) PutAuthLinkSocialIdentities(token string, body interface{}
 // Pass data in struct lrbody.EmailStr as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte or map[string]string{} could also be passed as body
 func (lr Loginradius) PutResendEmailVerification(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PutResendEmailVerificationWithContext(context.Background(), body, queries...)
+}
+
+// PutResendEmailVerificationWithContext is the same as PutResendEmailVerification with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutResendEmailVerificationWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPutReq("/identity/v2/auth/register", body)
 	if err != nil {
 		return nil, err
@@ -108,7 +137,8 @@ func (lr Loginradius) PutResendEmailVerification(body interface{}, queries ...in
 		}
 	}
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PutResendEmailVerification", PathTemplate: "/identity/v2/auth/register"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -125,12 +155,19 @@ func (lr Loginradius) PutResendEmailVerification(body interface{}, queries ...in
 // Pass data in struct lrbody.ResetPw as body to help ensure parameters satisfy API requirement;alternatively,
 // []byte or map[string]string{} could also be passed as body
 func (lr Loginradius) PutAuthResetPasswordByResetToken(body interface{}) (*httprutils.Response, error) {
+	return lr.PutAuthResetPasswordByResetTokenWithContext(context.Background(), body)
+}
+
+// PutAuthResetPasswordByResetTokenWithContext is the same as PutAuthResetPasswordByResetToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutAuthResetPasswordByResetTokenWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPutReq("/identity/v2/auth/password/reset", body)
 	if err != nil {
 		return nil, err
 	}
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PutAuthResetPasswordByResetToken", PathTemplate: "/identity/v2/auth/password/reset"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -147,6 +184,12 @@ func (lr Loginradius) PutAuthResetPasswordByResetToken(body interface{}) (*httpr
 // Pass data in struct lrbody.ResetPwOtp as body to help ensure parameters satisfy API requirements;alternatively,
 // []byte or map[string]string{} could also be passed as body
 func (lr Loginradius) PutAuthResetPasswordByOTP(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PutAuthResetPasswordByOTPWithContext(context.Background(), body, queries...)
+}
+
+// PutAuthResetPasswordByOTPWithContext is the same as PutAuthResetPasswordByOTP with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutAuthResetPasswordByOTPWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPutReq("/identity/v2/auth/password/reset", body)
 
 	if err != nil {
@@ -167,7 +210,8 @@ func (lr Loginradius) PutAuthResetPasswordByOTP(body interface{}, queries ...int
 		}
 	}
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PutAuthResetPasswordByOTP", PathTemplate: "/identity/v2/auth/password/reset"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -186,8 +230,15 @@ func (lr Loginradius) PutAuthResetPasswordByOTP(body interface{}, queries ...int
 // Pass data in struct lrbody.ResetPwSecurityQuestionEmail as body to help ensure parameters satisfy API requirements;alternatively,
 // []byte could also be passed as body
 func (lr Loginradius) PutAuthResetPasswordBySecurityAnswerAndEmail(body interface{}) (*httprutils.Response, error) {
+	return lr.PutAuthResetPasswordBySecurityAnswerAndEmailWithContext(context.Background(), body)
+}
+
+// PutAuthResetPasswordBySecurityAnswerAndEmailWithContext is the same as PutAuthResetPasswordBySecurityAnswerAndEmail with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutAuthResetPasswordBySecurityAnswerAndEmailWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPutReq("/identity/v2/auth/password/securityanswer", body)
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PutAuthResetPasswordBySecurityAnswerAndEmail", PathTemplate: "/identity/v2/auth/password/securityanswer"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -206,8 +257,15 @@ func (lr Loginradius) PutAuthResetPasswordBySecurityAnswerAndEmail(body interfac
 // Pass data in struct lrbody.ResetPwSecurityQuestionPhone as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte could also be passed as body
 func (lr Loginradius) PutAuthResetPasswordBySecurityAnswerAndPhone(body interface{}) (*httprutils.Response, error) {
+	return lr.PutAuthResetPasswordBySecurityAnswerAndPhoneWithContext(context.Background(), body)
+}
+
+// PutAuthResetPasswordBySecurityAnswerAndPhoneWithContext is the same as PutAuthResetPasswordBySecurityAnswerAndPhone with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutAuthResetPasswordBySecurityAnswerAndPhoneWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPutReq("/identity/v2/auth/password/securityanswer", body)
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PutAuthResetPasswordBySecurityAnswerAndPhone", PathTemplate: "/identity/v2/auth/password/securityanswer"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -226,8 +284,15 @@ func (lr Loginradius) PutAuthResetPasswordBySecurityAnswerAndPhone(body interfac
 // Pass data in struct lrbody.ResetPwSecurityQuestionusername as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte could also be passed as body
 func (lr Loginradius) PutAuthResetPasswordBySecurityAnswerAndUsername(body interface{}) (*httprutils.Response, error) {
+	return lr.PutAuthResetPasswordBySecurityAnswerAndUsernameWithContext(context.Background(), body)
+}
+
+// PutAuthResetPasswordBySecurityAnswerAndUsernameWithContext is the same as PutAuthResetPasswordBySecurityAnswerAndUsername with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutAuthResetPasswordBySecurityAnswerAndUsernameWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPutReq("/identity/v2/auth/password/securityanswer", body)
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PutAuthResetPasswordBySecurityAnswerAndUsername", PathTemplate: "/identity/v2/auth/password/securityanswer"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -240,12 +305,19 @@ func (lr Loginradius) PutAuthResetPasswordBySecurityAnswerAndUsername(body inter
 // Pass data in struct lrbody.AuthUsername as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte or map[string]string{} could also be passed as body
 func (lr Loginradius) PutAuthSetOrChangeUsername(body interface{}) (*httprutils.Response, error) {
+	return lr.PutAuthSetOrChangeUsernameWithContext(context.Background(), body)
+}
+
+// PutAuthSetOrChangeUsernameWithContext is the same as PutAuthSetOrChangeUsername with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutAuthSetOrChangeUsernameWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPutReqWithToken("/identity/v2/auth/username", body)
 
 	if err != nil {
 		return nil, err
 	}
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PutAuthSetOrChangeUsername", PathTemplate: "/identity/v2/auth/username"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -260,6 +332,12 @@ func (lr Loginradius) PutAuthSetOrChangeUsername(body interface{}) (*httprutils.
 // Pass data in struct lrbody.UpdateProfile as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte or map[string]string{} could also be passed as body
 func (lr Loginradius) PutAuthUpdateProfileByToken(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PutAuthUpdateProfileByTokenWithContext(context.Background(), body, queries...)
+}
+
+// PutAuthUpdateProfileByTokenWithContext is the same as PutAuthUpdateProfileByToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutAuthUpdateProfileByTokenWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPutReqWithToken("/identity/v2/auth/account", body)
 	if err != nil {
 		return nil, err
@@ -279,7 +357,8 @@ func (lr Loginradius) PutAuthUpdateProfileByToken(body interface{}, queries ...i
 		}
 	}
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PutAuthUpdateProfileByToken", PathTemplate: "/identity/v2/auth/account"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -294,10 +373,17 @@ func (lr Loginradius) PutAuthUpdateProfileByToken(body interface{}, queries ...i
 
 // For more information on this parameter, please see: https://www.loginradius.com/docs/api/v2/dashboard/platform-security/password-policy#securityquestion4
 func (lr Loginradius) PutAuthUpdateSecurityQuestionByAccessToken(body interface{}) (*httprutils.Response, error) {
+	return lr.PutAuthUpdateSecurityQuestionByAccessTokenWithContext(context.Background(), body)
+}
+
+// PutAuthUpdateSecurityQuestionByAccessTokenWithContext is the same as PutAuthUpdateSecurityQuestionByAccessToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutAuthUpdateSecurityQuestionByAccessTokenWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPutReqWithToken("/identity/v2/auth/account", body)
 	if err != nil {
 		return nil, err
 	}
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrauthentication.PutAuthUpdateSecurityQuestionByAccessToken", PathTemplate: "/identity/v2/auth/account"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
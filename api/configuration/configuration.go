@@ -1,6 +1,8 @@
 package lrconfiguration
 
 import (
+	"context"
+
 	"github.com/LoginRadius/go-sdk/httprutils"
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
 )
@@ -10,10 +12,16 @@ import (
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/configuration/get-configurations
 // Required query parameter: apikey
 func (lr Loginradius) GetConfiguration() (*httprutils.Response, error) {
-	req := lr.Client.NewGetReq("")
-	req.URL = "https://config.lrcontent.com/ciam/appinfo"
+	return lr.GetConfigurationWithContext(context.Background())
+}
+
+// GetConfigurationWithContext is the same as GetConfiguration with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetConfigurationWithContext(ctx context.Context) (*httprutils.Response, error) {
+	req := lr.Client.NewGetReq("/ciam/appinfo")
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrconfiguration.GetConfiguration", PathTemplate: "/ciam/appinfo"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -23,6 +31,12 @@ func (lr Loginradius) GetConfiguration() (*httprutils.Response, error) {
 // Required query parameter: apikey
 // Optional query parameter: timedifference
 func (lr Loginradius) GetServerTime(queries ...interface{}) (*httprutils.Response, error) {
+	return lr.GetServerTimeWithContext(context.Background(), queries...)
+}
+
+// GetServerTimeWithContext is the same as GetServerTime with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetServerTimeWithContext(ctx context.Context, queries ...interface{}) (*httprutils.Response, error) {
 	req := lr.Client.NewGetReq("/identity/v2/serverinfo")
 	for _, arg := range queries {
 		allowedQueries := map[string]bool{"timedifference": true}
@@ -36,7 +50,8 @@ func (lr Loginradius) GetServerTime(queries ...interface{}) (*httprutils.Respons
 		}
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrconfiguration.GetServerTime", PathTemplate: "/identity/v2/serverinfo"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -44,6 +59,12 @@ func (lr Loginradius) GetServerTime(queries ...interface{}) (*httprutils.Respons
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/session/generate-sott-token
 // Optional query parameter: timedifference
 func (lr Loginradius) GetGenerateSottAPI(queries ...interface{}) (*httprutils.Response, error) {
+	return lr.GetGenerateSottAPIWithContext(context.Background(), queries...)
+}
+
+// GetGenerateSottAPIWithContext is the same as GetGenerateSottAPI with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetGenerateSottAPIWithContext(ctx context.Context, queries ...interface{}) (*httprutils.Response, error) {
 	req := lr.Client.NewGetReq("/identity/v2/manage/account/sott")
 	for _, arg := range queries {
 		allowedQueries := map[string]bool{"timedifference": true}
@@ -57,7 +78,8 @@ func (lr Loginradius) GetGenerateSottAPI(queries ...interface{}) (*httprutils.Re
 		}
 	}
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrconfiguration.GetGenerateSottAPI", PathTemplate: "/identity/v2/manage/account/sott"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -65,31 +87,22 @@ func (lr Loginradius) GetGenerateSottAPI(queries ...interface{}) (*httprutils.Re
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/get-active-session-details
 // Required query parameters: key, secret, access_token
 func (lr Loginradius) GetActiveSessionDetails() (*httprutils.Response, error) {
+	return lr.GetActiveSessionDetailsWithContext(context.Background())
+}
+
+// GetActiveSessionDetailsWithContext is the same as GetActiveSessionDetails with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetActiveSessionDetailsWithContext(ctx context.Context) (*httprutils.Response, error) {
 	req := lr.Client.NewGetReq(
-		"",
+		"/api/v2/access_token/activesession",
 		map[string]string{
 			"key":    lr.Client.Context.ApiKey,
 			"token":  lr.Client.Context.Token,
 			"secret": lr.Client.Context.ApiSecret,
 		},
 	)
-	req.URL = "http://api.loginradius.com/api/v2/access_token/activesession"
 	delete(req.QueryParams, "apiKey")
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrconfiguration.GetActiveSessionDetails", PathTemplate: "/api/v2/access_token/activesession"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
-	// data := new(ActiveSession)
-	// req, reqErr := CreateRequest("GET", "http://api.loginradius.com/api/v2/access_token/activesession", "")
-	// if reqErr != nil {
-	// 	return *data, reqErr
-	// }
-
-	// q := req.URL.Query()
-	// q.Add("token", accessToken)
-	// q.Add("key", os.Getenv("APIKEY"))
-	// q.Add("secret", os.Getenv("APISECRET"))
-	// req.URL.RawQuery = q.Encode()
-	// req.Header.Add("content-Type", "application/x-www-form-urlencoded")
-
-	// err := RunRequest(req, data)
-	// return *data, err
 }
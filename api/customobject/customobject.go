@@ -1,11 +1,12 @@
 package customobject
 
 import (
-	"fmt"
+	"context"
 
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
 
 	"github.com/LoginRadius/go-sdk/httprutils"
+	"github.com/LoginRadius/go-sdk/lrjson"
 )
 
 // PostCustomObjectCreateByUID is used to write information in JSON format to the custom object for the specified account.
@@ -20,6 +21,12 @@ import (
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/custom-object/create-custom-object-by-uid
 func (lr Loginradius) PostCustomObjectCreateByUID(uid string, queries interface{}, body interface{}) (*httprutils.Response, error) {
+	return lr.PostCustomObjectCreateByUIDWithContext(context.Background(), uid, queries, body)
+}
+
+// PostCustomObjectCreateByUIDWithContext is the same as PostCustomObjectCreateByUID with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostCustomObjectCreateByUIDWithContext(ctx context.Context, uid string, queries interface{}, body interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"objectname": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -32,7 +39,8 @@ func (lr Loginradius) PostCustomObjectCreateByUID(uid string, queries interface{
 	}
 
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "customobject.PostCustomObjectCreateByUID", PathTemplate: "/identity/v2/manage/account/{uid}/customobject"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -46,6 +54,12 @@ func (lr Loginradius) PostCustomObjectCreateByUID(uid string, queries interface{
 
 // Documentation - https://www.loginradius.com/docs/api/v2/customer-identity-api/custom-object/create-custom-object-by-token
 func (lr Loginradius) PostCustomObjectCreateByToken(queries interface{}, body interface{}) (*httprutils.Response, error) {
+	return lr.PostCustomObjectCreateByTokenWithContext(context.Background(), queries, body)
+}
+
+// PostCustomObjectCreateByTokenWithContext is the same as PostCustomObjectCreateByToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostCustomObjectCreateByTokenWithContext(ctx context.Context, queries interface{}, body interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"objectname": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -57,7 +71,8 @@ func (lr Loginradius) PostCustomObjectCreateByToken(queries interface{}, body in
 		return nil, err
 	}
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "customobject.PostCustomObjectCreateByToken", PathTemplate: "/identity/v2/auth/customobject"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -71,6 +86,12 @@ func (lr Loginradius) PostCustomObjectCreateByToken(queries interface{}, body in
 
 // Documentation - https://www.loginradius.com/docs/api/v2/customer-identity-api/custom-object/custom-object-by-objectrecordid-and-uid
 func (lr Loginradius) GetCustomObjectByObjectRecordIDAndUID(uid, objectRecordID string, queries interface{}) (*httprutils.Response, error) {
+	return lr.GetCustomObjectByObjectRecordIDAndUIDWithContext(context.Background(), uid, objectRecordID, queries)
+}
+
+// GetCustomObjectByObjectRecordIDAndUIDWithContext is the same as GetCustomObjectByObjectRecordIDAndUID with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetCustomObjectByObjectRecordIDAndUIDWithContext(ctx context.Context, uid, objectRecordID string, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"objectname": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -79,10 +100,17 @@ func (lr Loginradius) GetCustomObjectByObjectRecordIDAndUID(uid, objectRecordID
 
 	req := lr.Client.NewGetReq("/identity/v2/manage/account/"+uid+"/customobject/"+objectRecordID, validatedQueries)
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "customobject.GetCustomObjectByObjectRecordIDAndUID", PathTemplate: "/identity/v2/manage/account/{uid}/customobject/{objectRecordID}"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
+// GetCustomObjectByObjectRecordIDAndUIDTyped is the same as GetCustomObjectByObjectRecordIDAndUIDWithContext, returning the response decoded
+// into an lrjson.CustomObjectRecord.
+func (lr Loginradius) GetCustomObjectByObjectRecordIDAndUIDTyped(ctx context.Context, uid, objectRecordID string, queries interface{}) (*lrjson.CustomObjectRecord, error) {
+	return lrjson.Decode[lrjson.CustomObjectRecord](lr.GetCustomObjectByObjectRecordIDAndUIDWithContext(ctx, uid, objectRecordID, queries))
+}
+
 // GetCustomObjectByObjectRecordIDAndToken is used to retrieve the Custom Object data for the specified account.
 
 // Required query parameter: objectname - string; apikey - string
@@ -93,6 +121,12 @@ func (lr Loginradius) GetCustomObjectByObjectRecordIDAndUID(uid, objectRecordID
 
 // Documentation - https://www.loginradius.com/docs/api/v2/customer-identity-api/custom-object/custom-object-by-objectrecordid-and-token
 func (lr Loginradius) GetCustomObjectByObjectRecordIDAndToken(objectRecordID string, queries interface{}) (*httprutils.Response, error) {
+	return lr.GetCustomObjectByObjectRecordIDAndTokenWithContext(context.Background(), objectRecordID, queries)
+}
+
+// GetCustomObjectByObjectRecordIDAndTokenWithContext is the same as GetCustomObjectByObjectRecordIDAndToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetCustomObjectByObjectRecordIDAndTokenWithContext(ctx context.Context, objectRecordID string, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"objectname": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -103,16 +137,29 @@ func (lr Loginradius) GetCustomObjectByObjectRecordIDAndToken(objectRecordID str
 	if err != nil {
 		return nil, err
 	}
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "customobject.GetCustomObjectByObjectRecordIDAndToken", PathTemplate: "/identity/v2/auth/customobject/{objectRecordID}"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
+// GetCustomObjectByObjectRecordIDAndTokenTyped is the same as GetCustomObjectByObjectRecordIDAndTokenWithContext, returning the response decoded
+// into an lrjson.CustomObjectRecord.
+func (lr Loginradius) GetCustomObjectByObjectRecordIDAndTokenTyped(ctx context.Context, objectRecordID string, queries interface{}) (*lrjson.CustomObjectRecord, error) {
+	return lrjson.Decode[lrjson.CustomObjectRecord](lr.GetCustomObjectByObjectRecordIDAndTokenWithContext(ctx, objectRecordID, queries))
+}
+
 // GetCustomObjectByToken is used to retrieve the specified Custom Object data for the specified account.
 
 // Required parameters: objectname - string; apikey - string
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/custom-object/custom-object-by-token
 func (lr Loginradius) GetCustomObjectByToken(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetCustomObjectByTokenWithContext(context.Background(), queries)
+}
+
+// GetCustomObjectByTokenWithContext is the same as GetCustomObjectByToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetCustomObjectByTokenWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"objectname": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -124,10 +171,17 @@ func (lr Loginradius) GetCustomObjectByToken(queries interface{}) (*httprutils.R
 		return nil, err
 	}
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "customobject.GetCustomObjectByToken", PathTemplate: "/identity/v2/auth/customobject"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
+// GetCustomObjectByTokenTyped is the same as GetCustomObjectByTokenWithContext, returning the response decoded
+// into an lrjson.CustomObjectList.
+func (lr Loginradius) GetCustomObjectByTokenTyped(ctx context.Context, queries interface{}) (*lrjson.CustomObjectList, error) {
+	return lrjson.Decode[lrjson.CustomObjectList](lr.GetCustomObjectByTokenWithContext(ctx, queries))
+}
+
 // GetCustomObjectByUID is used to retrieve all the custom objects by UID from cloud storage.
 
 // Required parameters: objectname - string
@@ -136,6 +190,12 @@ func (lr Loginradius) GetCustomObjectByToken(queries interface{}) (*httprutils.R
 
 // Documentation - https://www.loginradius.com/docs/api/v2/customer-identity-api/custom-object/custom-object-by-uid
 func (lr Loginradius) GetCustomObjectByUID(uid string, queries interface{}) (*httprutils.Response, error) {
+	return lr.GetCustomObjectByUIDWithContext(context.Background(), uid, queries)
+}
+
+// GetCustomObjectByUIDWithContext is the same as GetCustomObjectByUID with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetCustomObjectByUIDWithContext(ctx context.Context, uid string, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"objectname": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -144,10 +204,17 @@ func (lr Loginradius) GetCustomObjectByUID(uid string, queries interface{}) (*ht
 
 	req := lr.Client.NewGetReq("/identity/v2/manage/account/"+uid+"/customobject/", validatedQueries)
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "customobject.GetCustomObjectByUID", PathTemplate: "/identity/v2/manage/account/{uid}/customobject"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
+// GetCustomObjectByUIDTyped is the same as GetCustomObjectByUIDWithContext, returning the response decoded
+// into an lrjson.CustomObjectList.
+func (lr Loginradius) GetCustomObjectByUIDTyped(ctx context.Context, uid string, queries interface{}) (*lrjson.CustomObjectList, error) {
+	return lrjson.Decode[lrjson.CustomObjectList](lr.GetCustomObjectByUIDWithContext(ctx, uid, queries))
+}
+
 // PutCustomObjectUpdateByUID is used to update the specified custom object data of a specified account.
 
 // Post parameters:the fields that need to be changed.
@@ -161,6 +228,12 @@ func (lr Loginradius) GetCustomObjectByUID(uid string, queries interface{}) (*ht
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/custom-object/custom-object-update-by-objectrecordid-and-uid
 func (lr Loginradius) PutCustomObjectUpdateByUID(uid, objectrecordid string, queries, body interface{}) (*httprutils.Response, error) {
+	return lr.PutCustomObjectUpdateByUIDWithContext(context.Background(), uid, objectrecordid, queries, body)
+}
+
+// PutCustomObjectUpdateByUIDWithContext is the same as PutCustomObjectUpdateByUID with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutCustomObjectUpdateByUIDWithContext(ctx context.Context, uid, objectrecordid string, queries, body interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"objectname": true, "updatetype": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -172,7 +245,8 @@ func (lr Loginradius) PutCustomObjectUpdateByUID(uid, objectrecordid string, que
 	}
 	lr.Client.AddApiCredentialsToReqHeader(req)
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "customobject.PutCustomObjectUpdateByUID", PathTemplate: "/identity/v2/manage/account/{uid}/customobject/{objectRecordID}"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -189,6 +263,12 @@ func (lr Loginradius) PutCustomObjectUpdateByUID(uid, objectrecordid string, que
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/custom-object/custom-object-update-by-objectrecordid-and-token
 func (lr Loginradius) PutCustomObjectUpdateByToken(objectrecordid string, queries, body interface{}) (*httprutils.Response, error) {
+	return lr.PutCustomObjectUpdateByTokenWithContext(context.Background(), objectrecordid, queries, body)
+}
+
+// PutCustomObjectUpdateByTokenWithContext is the same as PutCustomObjectUpdateByToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutCustomObjectUpdateByTokenWithContext(ctx context.Context, objectrecordid string, queries, body interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"objectname": true, "updatetype": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -199,7 +279,8 @@ func (lr Loginradius) PutCustomObjectUpdateByToken(objectrecordid string, querie
 		return nil, err
 	}
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "customobject.PutCustomObjectUpdateByToken", PathTemplate: "/identity/v2/auth/customobject/{objectRecordID}"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -212,6 +293,12 @@ func (lr Loginradius) PutCustomObjectUpdateByToken(objectrecordid string, querie
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/custom-object/custom-object-delete-by-objectrecordid-and-uid
 func (lr Loginradius) DeleteCustomObjectByObjectRecordIDAndUID(uid, objectRecordId string, queries interface{}) (*httprutils.Response, error) {
+	return lr.DeleteCustomObjectByObjectRecordIDAndUIDWithContext(context.Background(), uid, objectRecordId, queries)
+}
+
+// DeleteCustomObjectByObjectRecordIDAndUIDWithContext is the same as DeleteCustomObjectByObjectRecordIDAndUID with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteCustomObjectByObjectRecordIDAndUIDWithContext(ctx context.Context, uid, objectRecordId string, queries interface{}) (*httprutils.Response, error) {
 
 	allowedQueries := map[string]bool{"objectname": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
@@ -222,7 +309,8 @@ func (lr Loginradius) DeleteCustomObjectByObjectRecordIDAndUID(uid, objectRecord
 	req.QueryParams = validatedQueries
 	lr.Client.AddApiCredentialsToReqHeader(req)
 	req.Headers["content-Type"] = "application/json"
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "customobject.DeleteCustomObjectByObjectRecordIDAndUID", PathTemplate: "/identity/v2/manage/account/{uid}/customobject/{objectRecordID}"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -236,6 +324,12 @@ func (lr Loginradius) DeleteCustomObjectByObjectRecordIDAndUID(uid, objectRecord
 
 // Documentation - https://www.loginradius.com/docs/api/v2/customer-identity-api/custom-object/custom-object-delete-by-objectrecordid-and-token
 func (lr Loginradius) DeleteCustomObjectByObjectRecordIDAndToken(objectRecordId string, queries interface{}) (*httprutils.Response, error) {
+	return lr.DeleteCustomObjectByObjectRecordIDAndTokenWithContext(context.Background(), objectRecordId, queries)
+}
+
+// DeleteCustomObjectByObjectRecordIDAndTokenWithContext is the same as DeleteCustomObjectByObjectRecordIDAndToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteCustomObjectByObjectRecordIDAndTokenWithContext(ctx context.Context, objectRecordId string, queries interface{}) (*httprutils.Response, error) {
 
 	allowedQueries := map[string]bool{"objectname": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
@@ -246,10 +340,10 @@ func (lr Loginradius) DeleteCustomObjectByObjectRecordIDAndToken(objectRecordId
 	if err != nil {
 		return nil, err
 	}
-	fmt.Println(req)
 	req.QueryParams = validatedQueries
 	req.Headers["content-Type"] = "application/json"
 	lr.Client.NormalizeApiKey(req)
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "customobject.DeleteCustomObjectByObjectRecordIDAndToken", PathTemplate: "/identity/v2/auth/customobject/{objectRecordID}"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
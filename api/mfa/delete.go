@@ -1,6 +1,8 @@
 package mfa
 
 import (
+	"context"
+
 	"github.com/LoginRadius/go-sdk/httprutils"
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
 )
@@ -13,6 +15,12 @@ import (
 
 // Required body parameter: googleauthenticator - pass true as value
 func (lr Loginradius) DeleteMFAResetGoogleAuthenticatorByToken() (*httprutils.Response, error) {
+	return lr.DeleteMFAResetGoogleAuthenticatorByTokenWithContext(context.Background())
+}
+
+// DeleteMFAResetGoogleAuthenticatorByTokenWithContext is the same as DeleteMFAResetGoogleAuthenticatorByToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteMFAResetGoogleAuthenticatorByTokenWithContext(ctx context.Context) (*httprutils.Response, error) {
 	req, err := lr.Client.NewDeleteReqWithToken(
 		"/identity/v2/auth/account/2fa/authenticator",
 		map[string]bool{"googleauthenticator": true},
@@ -22,7 +30,8 @@ func (lr Loginradius) DeleteMFAResetGoogleAuthenticatorByToken() (*httprutils.Re
 	}
 	lr.Client.NormalizeApiKey(req)
 	req.Headers["content-Type"] = "application/json"
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.DeleteMFAResetGoogleAuthenticatorByToken", PathTemplate: "/identity/v2/auth/account/2fa/authenticator"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -34,6 +43,12 @@ func (lr Loginradius) DeleteMFAResetGoogleAuthenticatorByToken() (*httprutils.Re
 
 // Required body parameter: otpauthenticator - pass true as value
 func (lr Loginradius) DeleteMFAResetSMSAuthenticatorByToken() (*httprutils.Response, error) {
+	return lr.DeleteMFAResetSMSAuthenticatorByTokenWithContext(context.Background())
+}
+
+// DeleteMFAResetSMSAuthenticatorByTokenWithContext is the same as DeleteMFAResetSMSAuthenticatorByToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteMFAResetSMSAuthenticatorByTokenWithContext(ctx context.Context) (*httprutils.Response, error) {
 	req, err := lr.Client.NewDeleteReqWithToken(
 		"/identity/v2/auth/account/2fa/authenticator",
 		map[string]bool{"otpauthenticator": true},
@@ -43,17 +58,24 @@ func (lr Loginradius) DeleteMFAResetSMSAuthenticatorByToken() (*httprutils.Respo
 	}
 	lr.Client.NormalizeApiKey(req)
 	req.Headers["content-Type"] = "application/json"
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.DeleteMFAResetSMSAuthenticatorByToken", PathTemplate: "/identity/v2/auth/account/2fa/authenticator"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
 // DeleteMFAResetSMSAuthenticatorByUid resets the SMS Authenticator configurations on a given account via the access_token.
 
 // Documentation https://www.loginradius.com/docs/api/v2/customer-identity-api/multi-factor-authentication/sms-authenticator/mfa-reset-sms-authenticator-by-uid
-// Required query parameter: apikey, apisecret, uid
+// Required query parameter: uid; the API credentials are sent in the request headers
 
 // Required body parameter: otpauthenticator - pass true as value
 func (lr Loginradius) DeleteMFAResetSMSAuthenticatorByUid(queries interface{}) (*httprutils.Response, error) {
+	return lr.DeleteMFAResetSMSAuthenticatorByUidWithContext(context.Background(), queries)
+}
+
+// DeleteMFAResetSMSAuthenticatorByUidWithContext is the same as DeleteMFAResetSMSAuthenticatorByUid with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteMFAResetSMSAuthenticatorByUidWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	queryParams := map[string]string{}
 	uid, ok := queries.(string)
 	if ok {
@@ -66,8 +88,6 @@ func (lr Loginradius) DeleteMFAResetSMSAuthenticatorByUid(queries interface{}) (
 		}
 		queryParams = validatedQueries
 	}
-	queryParams["apikey"] = lr.Client.Context.ApiKey
-	queryParams["apisecret"] = lr.Client.Context.ApiSecret
 
 	req := lr.Client.NewDeleteReq(
 		"/identity/v2/manage/account/2fa/authenticator",
@@ -76,7 +96,9 @@ func (lr Loginradius) DeleteMFAResetSMSAuthenticatorByUid(queries interface{}) (
 	)
 	req.QueryParams = queryParams
 	req.Headers = httprutils.JSONHeader
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	lr.Client.AddApiCredentialsToReqHeader(req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.DeleteMFAResetSMSAuthenticatorByUid", PathTemplate: "/identity/v2/manage/account/2fa/authenticator"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -84,10 +106,16 @@ func (lr Loginradius) DeleteMFAResetSMSAuthenticatorByUid(queries interface{}) (
 
 // Documentation https://www.loginradius.com/docs/api/v2/customer-identity-api/multi-factor-authentication/google-authenticator/mfa-reset-google-authenticator-by-uid
 
-// Required query parameter: apikey, apisecret, uid
+// Required query parameter: uid; the API credentials are sent in the request headers
 
 // Required body parameter: googleauthenticator - pass true as value
 func (lr Loginradius) DeleteMFAResetGoogleAuthenticatorByUid(queries interface{}) (*httprutils.Response, error) {
+	return lr.DeleteMFAResetGoogleAuthenticatorByUidWithContext(context.Background(), queries)
+}
+
+// DeleteMFAResetGoogleAuthenticatorByUidWithContext is the same as DeleteMFAResetGoogleAuthenticatorByUid with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteMFAResetGoogleAuthenticatorByUidWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	queryParams := map[string]string{}
 	uid, ok := queries.(string)
 	if ok {
@@ -100,8 +128,6 @@ func (lr Loginradius) DeleteMFAResetGoogleAuthenticatorByUid(queries interface{}
 		}
 		queryParams = validatedQueries
 	}
-	queryParams["apikey"] = lr.Client.Context.ApiKey
-	queryParams["apisecret"] = lr.Client.Context.ApiSecret
 
 	req := lr.Client.NewDeleteReq(
 		"/identity/v2/manage/account/2fa/authenticator",
@@ -109,6 +135,8 @@ func (lr Loginradius) DeleteMFAResetGoogleAuthenticatorByUid(queries interface{}
 	)
 	req.Headers = httprutils.JSONHeader
 	req.QueryParams = queryParams
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	lr.Client.AddApiCredentialsToReqHeader(req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.DeleteMFAResetGoogleAuthenticatorByUid", PathTemplate: "/identity/v2/manage/account/2fa/authenticator"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
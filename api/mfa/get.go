@@ -1,8 +1,11 @@
 package mfa
 
 import (
+	"context"
+
 	"github.com/LoginRadius/go-sdk/httprutils"
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
+	"github.com/LoginRadius/go-sdk/lrjson"
 )
 
 // GetMFAValidateAccessToken is used to configure the Multi-factor authentication
@@ -17,6 +20,12 @@ import (
 
 // Needs Authorization Bearer token header
 func (lr Loginradius) GetMFAValidateAccessToken(queries ...interface{}) (*httprutils.Response, error) {
+	return lr.GetMFAValidateAccessTokenWithContext(context.Background(), queries...)
+}
+
+// GetMFAValidateAccessTokenWithContext is the same as GetMFAValidateAccessToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetMFAValidateAccessTokenWithContext(ctx context.Context, queries ...interface{}) (*httprutils.Response, error) {
 	queryParams := map[string]string{}
 
 	for _, arg := range queries {
@@ -34,7 +43,8 @@ func (lr Loginradius) GetMFAValidateAccessToken(queries ...interface{}) (*httpru
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.GetMFAValidateAccessToken", PathTemplate: "/identity/v2/auth/account/2fa"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -44,30 +54,56 @@ func (lr Loginradius) GetMFAValidateAccessToken(queries ...interface{}) (*httpru
 
 // Required query parameter: apikey
 func (lr Loginradius) GetMFABackUpCodeByAccessToken() (*httprutils.Response, error) {
+	return lr.GetMFABackUpCodeByAccessTokenWithContext(context.Background())
+}
+
+// GetMFABackUpCodeByAccessTokenWithContext is the same as GetMFABackUpCodeByAccessToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetMFABackUpCodeByAccessTokenWithContext(ctx context.Context) (*httprutils.Response, error) {
 	req, err := lr.Client.NewGetReqWithToken("/identity/v2/auth/account/2fa/backupcode")
 	if err != nil {
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.GetMFABackUpCodeByAccessToken", PathTemplate: "/identity/v2/auth/account/2fa/backupcode"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
+// GetMFABackUpCodeByAccessTokenTyped is the same as GetMFABackUpCodeByAccessTokenWithContext, returning the response decoded
+// into an lrjson.BackupCodes.
+func (lr Loginradius) GetMFABackUpCodeByAccessTokenTyped(ctx context.Context) (*lrjson.BackupCodes, error) {
+	return lrjson.Decode[lrjson.BackupCodes](lr.GetMFABackUpCodeByAccessTokenWithContext(ctx))
+}
+
 //GetMFABackUpCodeByAccessToken is used to reset the backup codes on a given account via the access_token. This API call will generate 10 new codes, each code can only be consumed once.
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/multi-factor-authentication/mfa-reset-backup-code-by-access-token
 
 // Required query parameter: apikey
 func (lr Loginradius) GetMFAResetBackUpCodeByAccessToken() (*httprutils.Response, error) {
+	return lr.GetMFAResetBackUpCodeByAccessTokenWithContext(context.Background())
+}
+
+// GetMFAResetBackUpCodeByAccessTokenWithContext is the same as GetMFAResetBackUpCodeByAccessToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetMFAResetBackUpCodeByAccessTokenWithContext(ctx context.Context) (*httprutils.Response, error) {
 	req, err := lr.Client.NewGetReqWithToken("/identity/v2/auth/account/2fa/backupcode/reset")
 	if err != nil {
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.GetMFAResetBackUpCodeByAccessToken", PathTemplate: "/identity/v2/auth/account/2fa/backupcode/reset"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
+// GetMFAResetBackUpCodeByAccessTokenTyped is the same as GetMFAResetBackUpCodeByAccessTokenWithContext, returning the response decoded
+// into an lrjson.BackupCodes.
+func (lr Loginradius) GetMFAResetBackUpCodeByAccessTokenTyped(ctx context.Context) (*lrjson.BackupCodes, error) {
+	return lrjson.Decode[lrjson.BackupCodes](lr.GetMFAResetBackUpCodeByAccessTokenWithContext(ctx))
+}
+
 // GetMFABackUpCodeByUID is used to get a set of backup codes to allow the user login on a site that has Multi-factor
 // authentication enabled in the event that the user does not have a secondary factor available.
 // We generate 10 codes, each code can only be consumed once.
@@ -78,6 +114,12 @@ func (lr Loginradius) GetMFAResetBackUpCodeByAccessToken() (*httprutils.Response
 
 // Required query parameter: apikey, apisecret, uid
 func (lr Loginradius) GetMFABackUpCodeByUID(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetMFABackUpCodeByUIDWithContext(context.Background(), queries)
+}
+
+// GetMFABackUpCodeByUIDWithContext is the same as GetMFABackUpCodeByUID with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetMFABackUpCodeByUIDWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	queryParams := map[string]string{}
 	uid, ok := queries.(string)
 	if ok {
@@ -93,10 +135,17 @@ func (lr Loginradius) GetMFABackUpCodeByUID(queries interface{}) (*httprutils.Re
 
 	req := lr.Client.NewGetReq("/identity/v2/manage/account/2fa/backupcode", queryParams)
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.GetMFABackUpCodeByUID", PathTemplate: "/identity/v2/manage/account/2fa/backupcode"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
+// GetMFABackUpCodeByUIDTyped is the same as GetMFABackUpCodeByUIDWithContext, returning the response decoded
+// into an lrjson.BackupCodes.
+func (lr Loginradius) GetMFABackUpCodeByUIDTyped(ctx context.Context, queries interface{}) (*lrjson.BackupCodes, error) {
+	return lrjson.Decode[lrjson.BackupCodes](lr.GetMFABackUpCodeByUIDWithContext(ctx, queries))
+}
+
 //GetMFAResetBackUpCodeByUID is used to reset the backup codes on a given account via the UID.
 //This API call will generate 10 new codes, each code can only be consumed once.
 
@@ -104,6 +153,12 @@ func (lr Loginradius) GetMFABackUpCodeByUID(queries interface{}) (*httprutils.Re
 
 // Required query parameter: apikey, apisecret, uid
 func (lr Loginradius) GetMFAResetBackUpCodeByUID(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetMFAResetBackUpCodeByUIDWithContext(context.Background(), queries)
+}
+
+// GetMFAResetBackUpCodeByUIDWithContext is the same as GetMFAResetBackUpCodeByUID with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetMFAResetBackUpCodeByUIDWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	queryParams := map[string]string{}
 	uid, ok := queries.(string)
 	if ok {
@@ -119,10 +174,17 @@ func (lr Loginradius) GetMFAResetBackUpCodeByUID(queries interface{}) (*httpruti
 
 	req := lr.Client.NewGetReq("/identity/v2/manage/account/2fa/backupcode/reset", queryParams)
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.GetMFAResetBackUpCodeByUID", PathTemplate: "/identity/v2/manage/account/2fa/backupcode/reset"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
+// GetMFAResetBackUpCodeByUIDTyped is the same as GetMFAResetBackUpCodeByUIDWithContext, returning the response decoded
+// into an lrjson.BackupCodes.
+func (lr Loginradius) GetMFAResetBackUpCodeByUIDTyped(ctx context.Context, queries interface{}) (*lrjson.BackupCodes, error) {
+	return lrjson.Decode[lrjson.BackupCodes](lr.GetMFAResetBackUpCodeByUIDWithContext(ctx, queries))
+}
+
 // This API is used to trigger the Multi-Factor Autentication workflow for the provided access_token
 
 // Documentation https://www.loginradius.com/docs/api/v2/customer-identity-api/multi-factor-authentication/re-authentication/mfa-re-authenticate
@@ -131,6 +193,12 @@ func (lr Loginradius) GetMFAResetBackUpCodeByUID(queries interface{}) (*httpruti
 
 // Optional query parameter: smstemplate2fa
 func (lr Loginradius) GetMFAReAuthenticate(queries ...interface{}) (*httprutils.Response, error) {
+	return lr.GetMFAReAuthenticateWithContext(context.Background(), queries...)
+}
+
+// GetMFAReAuthenticateWithContext is the same as GetMFAReAuthenticate with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetMFAReAuthenticateWithContext(ctx context.Context, queries ...interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewGetReqWithToken("/identity/v2/auth/account/reauth/2fa")
 	for _, arg := range queries {
 		allowedQueries := map[string]bool{"smstemplate2fa": true}
@@ -144,6 +212,7 @@ func (lr Loginradius) GetMFAReAuthenticate(queries ...interface{}) (*httprutils.
 		}
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.GetMFAReAuthenticate", PathTemplate: "/identity/v2/auth/account/reauth/2fa"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
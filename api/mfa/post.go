@@ -1,8 +1,11 @@
 package mfa
 
 import (
+	"context"
+
 	"github.com/LoginRadius/go-sdk/httprutils"
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
+	"github.com/LoginRadius/go-sdk/lrjson"
 )
 
 // PostMFAEmailLogin can be used to login by emailid on a Multi-factor authentication enabled LoginRadius site.
@@ -15,6 +18,12 @@ import (
 
 // Required post parameters: email - string; password - string;
 func (lr Loginradius) PostMFAEmailLogin(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PostMFAEmailLoginWithContext(context.Background(), body, queries...)
+}
+
+// PostMFAEmailLoginWithContext is the same as PostMFAEmailLogin with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostMFAEmailLoginWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPostReq("/identity/v2/auth/login/2fa", body)
 	for _, arg := range queries {
 		allowedQueries := map[string]bool{
@@ -29,10 +38,17 @@ func (lr Loginradius) PostMFAEmailLogin(body interface{}, queries ...interface{}
 			request.QueryParams[k] = v
 		}
 	}
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "mfa.PostMFAEmailLogin", PathTemplate: "/identity/v2/auth/login/2fa"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
+// PostMFAEmailLoginTyped is the same as PostMFAEmailLoginWithContext, returning the response decoded
+// into an lrjson.MFALogin.
+func (lr Loginradius) PostMFAEmailLoginTyped(ctx context.Context, body interface{}, queries ...interface{}) (*lrjson.MFALogin, error) {
+	return lrjson.Decode[lrjson.MFALogin](lr.PostMFAEmailLoginWithContext(ctx, body, queries...))
+}
+
 // PostMFAUsernameLogin can be used to login by username on a Multi factor authentication enabled LoginRadius site.
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/multi-factor-authentication/mfa-user-name-login
@@ -43,6 +59,12 @@ func (lr Loginradius) PostMFAEmailLogin(body interface{}, queries ...interface{}
 
 // Required post parameters: username - string; password - string;
 func (lr Loginradius) PostMFAUsernameLogin(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PostMFAUsernameLoginWithContext(context.Background(), body, queries...)
+}
+
+// PostMFAUsernameLoginWithContext is the same as PostMFAUsernameLogin with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostMFAUsernameLoginWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPostReq("/identity/v2/auth/login/2fa", body)
 	for _, arg := range queries {
 		allowedQueries := map[string]bool{
@@ -57,10 +79,17 @@ func (lr Loginradius) PostMFAUsernameLogin(body interface{}, queries ...interfac
 			request.QueryParams[k] = v
 		}
 	}
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "mfa.PostMFAUsernameLogin", PathTemplate: "/identity/v2/auth/login/2fa"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
+// PostMFAUsernameLoginTyped is the same as PostMFAUsernameLoginWithContext, returning the response decoded
+// into an lrjson.MFALogin.
+func (lr Loginradius) PostMFAUsernameLoginTyped(ctx context.Context, body interface{}, queries ...interface{}) (*lrjson.MFALogin, error) {
+	return lrjson.Decode[lrjson.MFALogin](lr.PostMFAUsernameLoginWithContext(ctx, body, queries...))
+}
+
 // PostMFAPhoneLogin can be used to login by Phone on a Multi factor authentication enabled LoginRadius site.
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/multi-factor-authentication/mfa-uphone-login
@@ -71,6 +100,12 @@ func (lr Loginradius) PostMFAUsernameLogin(body interface{}, queries ...interfac
 
 // Required post parameters: phone - string; password - string;
 func (lr Loginradius) PostMFAPhoneLogin(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PostMFAPhoneLoginWithContext(context.Background(), body, queries...)
+}
+
+// PostMFAPhoneLoginWithContext is the same as PostMFAPhoneLogin with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostMFAPhoneLoginWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPostReq("/identity/v2/auth/login/2fa", body)
 	for _, arg := range queries {
 		allowedQueries := map[string]bool{
@@ -85,6 +120,13 @@ func (lr Loginradius) PostMFAPhoneLogin(body interface{}, queries ...interface{}
 			request.QueryParams[k] = v
 		}
 	}
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "mfa.PostMFAPhoneLogin", PathTemplate: "/identity/v2/auth/login/2fa"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
+
+// PostMFAPhoneLoginTyped is the same as PostMFAPhoneLoginWithContext, returning the response decoded
+// into an lrjson.MFALogin.
+func (lr Loginradius) PostMFAPhoneLoginTyped(ctx context.Context, body interface{}, queries ...interface{}) (*lrjson.MFALogin, error) {
+	return lrjson.Decode[lrjson.MFALogin](lr.PostMFAPhoneLoginWithContext(ctx, body, queries...))
+}
@@ -1,6 +1,8 @@
 package mfa
 
 import (
+	"context"
+
 	"github.com/LoginRadius/go-sdk/httprutils"
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
 )
@@ -17,6 +19,12 @@ import (
 
 // Required post parameter: googleauthenticatorcode: string
 func (lr Loginradius) PutMFAValidateGoogleAuthCode(queries, body interface{}) (*httprutils.Response, error) {
+	return lr.PutMFAValidateGoogleAuthCodeWithContext(context.Background(), queries, body)
+}
+
+// PutMFAValidateGoogleAuthCodeWithContext is the same as PutMFAValidateGoogleAuthCode with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutMFAValidateGoogleAuthCodeWithContext(ctx context.Context, queries, body interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"secondfactorauthenticationtoken": true, "smstemplate2fa": true,
 	}
@@ -30,7 +38,8 @@ func (lr Loginradius) PutMFAValidateGoogleAuthCode(queries, body interface{}) (*
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.PutMFAValidateGoogleAuthCode", PathTemplate: "/identity/v2/auth/login/2fa/verification/googleauthenticatorcode"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -46,6 +55,12 @@ func (lr Loginradius) PutMFAValidateGoogleAuthCode(queries, body interface{}) (*
 
 // Optional query parameters: securityanswer, g-recaptcha-response, qq_captcha_ticket, qq_captcha_randstr
 func (lr Loginradius) PutMFAValidateOTP(queries, body interface{}) (*httprutils.Response, error) {
+	return lr.PutMFAValidateOTPWithContext(context.Background(), queries, body)
+}
+
+// PutMFAValidateOTPWithContext is the same as PutMFAValidateOTP with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutMFAValidateOTPWithContext(ctx context.Context, queries, body interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"secondfactorauthenticationtoken": true, "smstemplate2fa": true,
 	}
@@ -59,7 +74,8 @@ func (lr Loginradius) PutMFAValidateOTP(queries, body interface{}) (*httprutils.
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.PutMFAValidateOTP", PathTemplate: "/identity/v2/auth/login/2fa/verification/otp"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -73,6 +89,12 @@ func (lr Loginradius) PutMFAValidateOTP(queries, body interface{}) (*httprutils.
 
 // Rerquired post parameters: googleauthenticatorcode - string
 func (lr Loginradius) PutMFAUpdateByToken(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PutMFAUpdateByTokenWithContext(context.Background(), body, queries...)
+}
+
+// PutMFAUpdateByTokenWithContext is the same as PutMFAUpdateByToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutMFAUpdateByTokenWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPutReqWithToken("/identity/v2/auth/account/2FA/Verification/GoogleAuthenticatorCode", body)
 	if err != nil {
 		return nil, err
@@ -92,7 +114,8 @@ func (lr Loginradius) PutMFAUpdateByToken(body interface{}, queries ...interface
 		}
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.PutMFAUpdateByToken", PathTemplate: "/identity/v2/auth/account/2FA/Verification/GoogleAuthenticatorCode"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -106,6 +129,12 @@ func (lr Loginradius) PutMFAUpdateByToken(body interface{}, queries ...interface
 
 // Required post parameter: phoneno2fa - string
 func (lr Loginradius) PutMFAUpdatePhoneNumber(queries, body interface{}) (*httprutils.Response, error) {
+	return lr.PutMFAUpdatePhoneNumberWithContext(context.Background(), queries, body)
+}
+
+// PutMFAUpdatePhoneNumberWithContext is the same as PutMFAUpdatePhoneNumber with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutMFAUpdatePhoneNumberWithContext(ctx context.Context, queries, body interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"secondfactorauthenticationtoken": true, "smstemplate2fa": true,
 	}
@@ -119,7 +148,8 @@ func (lr Loginradius) PutMFAUpdatePhoneNumber(queries, body interface{}) (*httpr
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.PutMFAUpdatePhoneNumber", PathTemplate: "/identity/v2/auth/login/2fa"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -133,6 +163,12 @@ func (lr Loginradius) PutMFAUpdatePhoneNumber(queries, body interface{}) (*httpr
 
 // Required post parameter: phoneno2fa - string
 func (lr Loginradius) PutMFAUpdatePhoneNumberByToken(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PutMFAUpdatePhoneNumberByTokenWithContext(context.Background(), body, queries...)
+}
+
+// PutMFAUpdatePhoneNumberByTokenWithContext is the same as PutMFAUpdatePhoneNumberByToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutMFAUpdatePhoneNumberByTokenWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPutReqWithToken("/identity/v2/auth/account/2fa", body)
 	if err != nil {
 		return nil, err
@@ -153,7 +189,8 @@ func (lr Loginradius) PutMFAUpdatePhoneNumberByToken(body interface{}, queries .
 	}
 
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.PutMFAUpdatePhoneNumberByToken", PathTemplate: "/identity/v2/auth/account/2fa"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -165,6 +202,12 @@ func (lr Loginradius) PutMFAUpdatePhoneNumberByToken(body interface{}, queries .
 
 // Required body parameter: backupcode
 func (lr Loginradius) PutMFAValidateBackupCode(queries interface{}, body interface{}) (*httprutils.Response, error) {
+	return lr.PutMFAValidateBackupCodeWithContext(context.Background(), queries, body)
+}
+
+// PutMFAValidateBackupCodeWithContext is the same as PutMFAValidateBackupCode with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutMFAValidateBackupCodeWithContext(ctx context.Context, queries interface{}, body interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"secondfactorauthenticationtoken": true,
 	}
@@ -178,7 +221,8 @@ func (lr Loginradius) PutMFAValidateBackupCode(queries interface{}, body interfa
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.PutMFAValidateBackupCode", PathTemplate: "/identity/v2/auth/login/2fa/verification/backupcode"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -190,12 +234,19 @@ func (lr Loginradius) PutMFAValidateBackupCode(queries interface{}, body interfa
 
 // Required body parameters: googleauthenticatorcode
 func (lr Loginradius) PutMFAReauthenticateByGoogleAuthenticator(body interface{}) (*httprutils.Response, error) {
+	return lr.PutMFAReauthenticateByGoogleAuthenticatorWithContext(context.Background(), body)
+}
+
+// PutMFAReauthenticateByGoogleAuthenticatorWithContext is the same as PutMFAReauthenticateByGoogleAuthenticator with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutMFAReauthenticateByGoogleAuthenticatorWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPutReqWithToken("/identity/v2/auth/account/reauth/2fa/GoogleAuthenticatorCode", body)
 	if err != nil {
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.PutMFAReauthenticateByGoogleAuthenticator", PathTemplate: "/identity/v2/auth/account/reauth/2fa/GoogleAuthenticatorCode"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -204,12 +255,19 @@ func (lr Loginradius) PutMFAReauthenticateByGoogleAuthenticator(body interface{}
 // Required query parameter: apikey
 // Required body parameters: backupcode
 func (lr Loginradius) PutMFAReauthenticateByBackupCode(body interface{}) (*httprutils.Response, error) {
+	return lr.PutMFAReauthenticateByBackupCodeWithContext(context.Background(), body)
+}
+
+// PutMFAReauthenticateByBackupCodeWithContext is the same as PutMFAReauthenticateByBackupCode with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutMFAReauthenticateByBackupCodeWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPutReqWithToken("/identity/v2/auth/account/reauth/2fa/BackupCode", body)
 	if err != nil {
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.PutMFAReauthenticateByBackupCode", PathTemplate: "/identity/v2/auth/account/reauth/2fa/BackupCode"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -223,12 +281,19 @@ func (lr Loginradius) PutMFAReauthenticateByBackupCode(body interface{}) (*httpr
 
 // Optional bodys parameters: securityanswer, qq_captcha_ticket, qq_captcha_Randstr, g-recaptcha-response
 func (lr Loginradius) PutMFAReauthenticateByOTP(body interface{}) (*httprutils.Response, error) {
+	return lr.PutMFAReauthenticateByOTPWithContext(context.Background(), body)
+}
+
+// PutMFAReauthenticateByOTPWithContext is the same as PutMFAReauthenticateByOTP with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutMFAReauthenticateByOTPWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPutReqWithToken("/identity/v2/auth/account/reauth/2fa/otp", body)
 	if err != nil {
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.PutMFAReauthenticateByOTP", PathTemplate: "/identity/v2/auth/account/reauth/2fa/otp"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -242,12 +307,19 @@ func (lr Loginradius) PutMFAReauthenticateByOTP(body interface{}) (*httprutils.R
 
 // Optional body parameters: securityanswer - object, qq_captcha_ticket, qq_captcha_Randstr, g-recaptcha-response
 func (lr Loginradius) PutMFAReauthenticateByPassword(body interface{}) (*httprutils.Response, error) {
+	return lr.PutMFAReauthenticateByPasswordWithContext(context.Background(), body)
+}
+
+// PutMFAReauthenticateByPasswordWithContext is the same as PutMFAReauthenticateByPassword with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutMFAReauthenticateByPasswordWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPutReqWithToken("/identity/v2/auth/account/reauth/password", body)
 	if err != nil {
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.PutMFAReauthenticateByPassword", PathTemplate: "/identity/v2/auth/account/reauth/password"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -261,11 +333,18 @@ func (lr Loginradius) PutMFAReauthenticateByPassword(body interface{}) (*httprut
 
 // optional body parameters: securityanswer - object; g-recaptcha-response - string; qq_captcha_ticket - string; qq-captcha-randstr - string
 func (lr Loginradius) PutMFAUpdateSettings(body interface{}) (*httprutils.Response, error) {
+	return lr.PutMFAUpdateSettingsWithContext(context.Background(), body)
+}
+
+// PutMFAUpdateSettingsWithContext is the same as PutMFAUpdateSettings with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutMFAUpdateSettingsWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPutReqWithToken("/identity/v2/auth/account/2FA/Verification/otp", body)
 	if err != nil {
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "mfa.PutMFAUpdateSettings", PathTemplate: "/identity/v2/auth/account/2FA/Verification/otp"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
@@ -1,6 +1,8 @@
 package onetouchlogin
 
 import (
+	"context"
+
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
 
 	"github.com/LoginRadius/go-sdk/httprutils"
@@ -13,6 +15,12 @@ import (
 // Required post parameters: clientguid - string; email - string; g-recaptcha-response - string;
 // Optional post parameters: qq_captcha_ticket - string; qq_captcha_randstr - string;
 func (lr Loginradius) PostOneTouchLoginByEmail(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PostOneTouchLoginByEmailWithContext(context.Background(), body, queries...)
+}
+
+// PostOneTouchLoginByEmailWithContext is the same as PostOneTouchLoginByEmail with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostOneTouchLoginByEmailWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	validatedQueries := map[string]string{}
 	for _, arg := range queries {
 		allowedQueries := map[string]bool{
@@ -32,7 +40,8 @@ func (lr Loginradius) PostOneTouchLoginByEmail(body interface{}, queries ...inte
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "onetouchlogin.PostOneTouchLoginByEmail", PathTemplate: "/identity/v2/auth/onetouchlogin/email"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -43,6 +52,12 @@ func (lr Loginradius) PostOneTouchLoginByEmail(body interface{}, queries ...inte
 // Required post parameters: clientguid - string; phone - string; g-recaptcha-response - string;
 // Optional post parameters: qq_captcha_ticket - string; qq_captcha_randstr - string;
 func (lr Loginradius) PostOneTouchLoginByPhone(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PostOneTouchLoginByPhoneWithContext(context.Background(), body, queries...)
+}
+
+// PostOneTouchLoginByPhoneWithContext is the same as PostOneTouchLoginByPhone with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostOneTouchLoginByPhoneWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	validatedQueries := map[string]string{}
 	for _, arg := range queries {
 		allowedQueries := map[string]bool{
@@ -62,7 +77,8 @@ func (lr Loginradius) PostOneTouchLoginByPhone(body interface{}, queries ...inte
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "onetouchlogin.PostOneTouchLoginByPhone", PathTemplate: "/identity/v2/auth/onetouchlogin/phone"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -72,6 +88,12 @@ func (lr Loginradius) PostOneTouchLoginByPhone(body interface{}, queries ...inte
 // Optional query parameter: smstemplate
 // Required post parameter: phone - string;
 func (lr Loginradius) PutOneTouchOTPVerification(queries, body interface{}) (*httprutils.Response, error) {
+	return lr.PutOneTouchOTPVerificationWithContext(context.Background(), queries, body)
+}
+
+// PutOneTouchOTPVerificationWithContext is the same as PutOneTouchOTPVerification with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutOneTouchOTPVerificationWithContext(ctx context.Context, queries, body interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"otp": true, "smstemplate": true,
 	}
@@ -84,6 +106,7 @@ func (lr Loginradius) PutOneTouchOTPVerification(queries, body interface{}) (*ht
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "onetouchlogin.PutOneTouchOTPVerification", PathTemplate: "/identity/v2/auth/onetouchlogin/phone/verify"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
@@ -1,8 +1,11 @@
 package phoneauthentication
 
 import (
+	"context"
+
 	"github.com/LoginRadius/go-sdk/httprutils"
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
+	"github.com/LoginRadius/go-sdk/lrjson"
 )
 
 // PostPhoneLogin retrieves a copy of the user data based on the Phone.
@@ -19,6 +22,12 @@ import (
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/phone-authentication/phone-login
 func (lr Loginradius) PostPhoneLogin(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PostPhoneLoginWithContext(context.Background(), body, queries...)
+}
+
+// PostPhoneLoginWithContext is the same as PostPhoneLogin with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostPhoneLoginWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPostReq("/identity/v2/auth/login", body)
 	for _, arg := range queries {
 		allowedQueries := map[string]bool{
@@ -34,10 +43,17 @@ func (lr Loginradius) PostPhoneLogin(body interface{}, queries ...interface{}) (
 		}
 	}
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "phoneauthentication.PostPhoneLogin", PathTemplate: "/identity/v2/auth/login"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
+// PostPhoneLoginTyped is the same as PostPhoneLoginWithContext, returning the response decoded
+// into an lrjson.AccessToken.
+func (lr Loginradius) PostPhoneLoginTyped(ctx context.Context, body interface{}, queries ...interface{}) (*lrjson.AccessToken, error) {
+	return lrjson.Decode[lrjson.AccessToken](lr.PostPhoneLoginWithContext(ctx, body, queries...))
+}
+
 // PostPhoneForgotPasswordByOTP is used to send the OTP to reset the account password.
 
 // Required query parameter: apikey - string
@@ -48,6 +64,12 @@ func (lr Loginradius) PostPhoneLogin(body interface{}, queries ...interface{}) (
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/phone-authentication/phone-forgot-password-by-otp
 func (lr Loginradius) PostPhoneForgotPasswordByOTP(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PostPhoneForgotPasswordByOTPWithContext(context.Background(), body, queries...)
+}
+
+// PostPhoneForgotPasswordByOTPWithContext is the same as PostPhoneForgotPasswordByOTP with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostPhoneForgotPasswordByOTPWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPostReq("/identity/v2/auth/password/otp", body)
 	if err != nil {
 		return nil, err
@@ -64,7 +86,8 @@ func (lr Loginradius) PostPhoneForgotPasswordByOTP(body interface{}, queries ...
 		}
 	}
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "phoneauthentication.PostPhoneForgotPasswordByOTP", PathTemplate: "/identity/v2/auth/password/otp"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -80,6 +103,12 @@ func (lr Loginradius) PostPhoneForgotPasswordByOTP(body interface{}, queries ...
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/phone-authentication/phone-resend-otp
 func (lr Loginradius) PostPhoneResendVerificationOTP(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PostPhoneResendVerificationOTPWithContext(context.Background(), body, queries...)
+}
+
+// PostPhoneResendVerificationOTPWithContext is the same as PostPhoneResendVerificationOTP with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostPhoneResendVerificationOTPWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPostReq("/identity/v2/auth/phone/otp", body)
 	if err != nil {
 		return nil, err
@@ -96,7 +125,8 @@ func (lr Loginradius) PostPhoneResendVerificationOTP(body interface{}, queries .
 		}
 	}
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "phoneauthentication.PostPhoneResendVerificationOTP", PathTemplate: "/identity/v2/auth/phone/otp"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -112,6 +142,12 @@ func (lr Loginradius) PostPhoneResendVerificationOTP(body interface{}, queries .
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/phone-authentication/phone-resend-otp-by-token
 func (lr Loginradius) PostPhoneResendVerificationOTPByToken(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PostPhoneResendVerificationOTPByTokenWithContext(context.Background(), body, queries...)
+}
+
+// PostPhoneResendVerificationOTPByTokenWithContext is the same as PostPhoneResendVerificationOTPByToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostPhoneResendVerificationOTPByTokenWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPostReqWithToken("/identity/v2/auth/phone/otp", body)
 	if err != nil {
 		return nil, err
@@ -128,7 +164,8 @@ func (lr Loginradius) PostPhoneResendVerificationOTPByToken(body interface{}, qu
 		}
 	}
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "phoneauthentication.PostPhoneResendVerificationOTPByToken", PathTemplate: "/identity/v2/auth/phone/otp"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -146,6 +183,12 @@ func (lr Loginradius) PostPhoneResendVerificationOTPByToken(body interface{}, qu
 // Required  parameter: sott
 
 func (lr Loginradius) PostPhoneUserRegistrationBySMS(sott string, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PostPhoneUserRegistrationBySMSWithContext(context.Background(), sott, body, queries...)
+}
+
+// PostPhoneUserRegistrationBySMSWithContext is the same as PostPhoneUserRegistrationBySMS with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostPhoneUserRegistrationBySMSWithContext(ctx context.Context, sott string, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	queryParams := map[string]string{}
 	for _, arg := range queries {
 		allowedQueries := map[string]bool{
@@ -167,7 +210,8 @@ func (lr Loginradius) PostPhoneUserRegistrationBySMS(sott string, body interface
 	}
 
 	request.Headers["X-LoginRadius-Sott"] = sott
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "phoneauthentication.PostPhoneUserRegistrationBySMS", PathTemplate: "/identity/v2/auth/register"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -179,6 +223,12 @@ func (lr Loginradius) PostPhoneUserRegistrationBySMS(sott string, body interface
 
 // Optional query parameter: smstemplate
 func (lr Loginradius) GetPhoneSendOTP(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetPhoneSendOTPWithContext(context.Background(), queries)
+}
+
+// GetPhoneSendOTPWithContext is the same as GetPhoneSendOTP with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetPhoneSendOTPWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"phone": true, "smstemplate": true,
 	}
@@ -189,7 +239,8 @@ func (lr Loginradius) GetPhoneSendOTP(queries interface{}) (*httprutils.Response
 	validatedQueries["apikey"] = lr.Client.Context.ApiKey
 	request := lr.Client.NewGetReq("/identity/v2/auth/login/passwordlesslogin/otp", validatedQueries)
 	delete(request.QueryParams, "apiKey")
-	res, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "phoneauthentication.GetPhoneSendOTP", PathTemplate: "/identity/v2/auth/login/passwordlesslogin/otp"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return res, err
 }
 
@@ -199,6 +250,12 @@ func (lr Loginradius) GetPhoneSendOTP(queries interface{}) (*httprutils.Response
 
 // Required query parameter: apikey, phone
 func (lr Loginradius) GetPhoneNumberAvailability(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetPhoneNumberAvailabilityWithContext(context.Background(), queries)
+}
+
+// GetPhoneNumberAvailabilityWithContext is the same as GetPhoneNumberAvailability with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetPhoneNumberAvailabilityWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"phone": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -206,7 +263,8 @@ func (lr Loginradius) GetPhoneNumberAvailability(queries interface{}) (*httpruti
 	}
 	req := lr.Client.NewGetReq("/identity/v2/auth/phone", validatedQueries)
 	lr.Client.NormalizeApiKey(req)
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "phoneauthentication.GetPhoneNumberAvailability", PathTemplate: "/identity/v2/auth/phone"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -222,6 +280,12 @@ func (lr Loginradius) GetPhoneNumberAvailability(queries interface{}) (*httpruti
 
 // Optional post parameters: securityanswer - string; g-recaptcha-response - string; qq_captcha_ticket - string; qq_captcha_randstr - string
 func (lr Loginradius) PutPhoneLoginUsingOTP(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PutPhoneLoginUsingOTPWithContext(context.Background(), body, queries...)
+}
+
+// PutPhoneLoginUsingOTPWithContext is the same as PutPhoneLoginUsingOTP with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutPhoneLoginUsingOTPWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	request, err := lr.Client.NewPutReq("/identity/v2/auth/login/passwordlesslogin/otp/verify", body)
 	for _, arg := range queries {
 		allowedQueries := map[string]bool{"smstemplate": true}
@@ -235,7 +299,8 @@ func (lr Loginradius) PutPhoneLoginUsingOTP(body interface{}, queries ...interfa
 		}
 	}
 	lr.Client.NormalizeApiKey(request)
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "phoneauthentication.PutPhoneLoginUsingOTP", PathTemplate: "/identity/v2/auth/login/passwordlesslogin/otp/verify"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -249,6 +314,12 @@ func (lr Loginradius) PutPhoneLoginUsingOTP(body interface{}, queries ...interfa
 
 // Required post parameter: phone - string (the new number to be updated for the account)
 func (lr Loginradius) PutPhoneNumberUpdate(body interface{}, queries ...interface{}) (*httprutils.Response, error) {
+	return lr.PutPhoneNumberUpdateWithContext(context.Background(), body, queries...)
+}
+
+// PutPhoneNumberUpdateWithContext is the same as PutPhoneNumberUpdate with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutPhoneNumberUpdateWithContext(ctx context.Context, body interface{}, queries ...interface{}) (*httprutils.Response, error) {
 	queryParams := map[string]string{}
 	for _, arg := range queries {
 		allowedQueries := map[string]bool{"smstemplate": true}
@@ -266,7 +337,8 @@ func (lr Loginradius) PutPhoneNumberUpdate(body interface{}, queries ...interfac
 	if err != nil {
 		return nil, err
 	}
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "phoneauthentication.PutPhoneNumberUpdate", PathTemplate: "/identity/v2/auth/phone"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -280,11 +352,18 @@ func (lr Loginradius) PutPhoneNumberUpdate(body interface{}, queries ...interfac
 
 // Required post parameters: phone - string; otp - string; password-string
 func (lr Loginradius) PutPhoneResetPasswordByOTP(body interface{}) (*httprutils.Response, error) {
+	return lr.PutPhoneResetPasswordByOTPWithContext(context.Background(), body)
+}
+
+// PutPhoneResetPasswordByOTPWithContext is the same as PutPhoneResetPasswordByOTP with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutPhoneResetPasswordByOTPWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPutReq("/identity/v2/auth/password/otp", body)
 	if err != nil {
 		return nil, err
 	}
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "phoneauthentication.PutPhoneResetPasswordByOTP", PathTemplate: "/identity/v2/auth/password/otp"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -298,6 +377,12 @@ func (lr Loginradius) PutPhoneResetPasswordByOTP(body interface{}) (*httprutils.
 
 // Required post parameter: phone - string
 func (lr Loginradius) PutPhoneVerificationByOTP(queries, body interface{}) (*httprutils.Response, error) {
+	return lr.PutPhoneVerificationByOTPWithContext(context.Background(), queries, body)
+}
+
+// PutPhoneVerificationByOTPWithContext is the same as PutPhoneVerificationByOTP with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutPhoneVerificationByOTPWithContext(ctx context.Context, queries, body interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"otp": true, "smstemplate": true,
 	}
@@ -310,7 +395,8 @@ func (lr Loginradius) PutPhoneVerificationByOTP(queries, body interface{}) (*htt
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "phoneauthentication.PutPhoneVerificationByOTP", PathTemplate: "/identity/v2/auth/phone/otp"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -326,6 +412,12 @@ func (lr Loginradius) PutPhoneVerificationByOTP(queries, body interface{}) (*htt
 
 // Requires Authorization Bearer token
 func (lr Loginradius) PutPhoneVerificationByOTPByToken(queries interface{}) (*httprutils.Response, error) {
+	return lr.PutPhoneVerificationByOTPByTokenWithContext(context.Background(), queries)
+}
+
+// PutPhoneVerificationByOTPByTokenWithContext is the same as PutPhoneVerificationByOTPByToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutPhoneVerificationByOTPByTokenWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"otp": true, "smstemplate": true,
 	}
@@ -339,7 +431,8 @@ func (lr Loginradius) PutPhoneVerificationByOTPByToken(queries interface{}) (*ht
 	}
 	lr.Client.NormalizeApiKey(req)
 
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "phoneauthentication.PutPhoneVerificationByOTPByToken", PathTemplate: "/identity/v2/auth/phone/otp"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -349,16 +442,20 @@ func (lr Loginradius) PutPhoneVerificationByOTPByToken(queries interface{}) (*ht
 
 // Required template parameter: string representing uid of the user profile
 func (lr Loginradius) PutResetPhoneIDVerification(uid string) (*httprutils.Response, error) {
+	return lr.PutResetPhoneIDVerificationWithContext(context.Background(), uid)
+}
+
+// PutResetPhoneIDVerificationWithContext is the same as PutResetPhoneIDVerification with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutResetPhoneIDVerificationWithContext(ctx context.Context, uid string) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPutReq("/identity/v2/manage/account/"+uid+"/invalidatephone", "")
 	if err != nil {
 		return nil, err
 	}
-	req.QueryParams = map[string]string{
-		"apikey":    lr.Client.Context.ApiKey,
-		"apisecret": lr.Client.Context.ApiSecret,
-	}
 	req.Headers = httprutils.URLEncodedHeader
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	lr.Client.AddApiCredentialsToReqHeader(req)
+	req.Endpoint = httprutils.Endpoint{Operation: "phoneauthentication.PutResetPhoneIDVerification", PathTemplate: "/identity/v2/manage/account/{uid}/invalidatephone"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -368,10 +465,17 @@ func (lr Loginradius) PutResetPhoneIDVerification(uid string) (*httprutils.Respo
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/phone-authentication/remove-phone-id-by-access-token
 func (lr Loginradius) DeleteRemovePhoneIDByAccessToken() (*httprutils.Response, error) {
+	return lr.DeleteRemovePhoneIDByAccessTokenWithContext(context.Background())
+}
+
+// DeleteRemovePhoneIDByAccessTokenWithContext is the same as DeleteRemovePhoneIDByAccessToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteRemovePhoneIDByAccessTokenWithContext(ctx context.Context) (*httprutils.Response, error) {
 	req, err := lr.Client.NewDeleteReqWithToken("/identity/v2/auth/phone", "")
 	if err != nil {
 		return nil, err
 	}
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "phoneauthentication.DeleteRemovePhoneIDByAccessToken", PathTemplate: "/identity/v2/auth/phone"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
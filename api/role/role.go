@@ -1,9 +1,10 @@
 package role
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/LoginRadius/go-sdk/httprutils"
+	"github.com/LoginRadius/go-sdk/lrjson"
 )
 
 // PostRolesCreate creates a role with permissions.
@@ -17,12 +18,19 @@ import (
 // Pass data in struct lrbody.Roles as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte could also be passed as body
 func (lr Loginradius) PostRolesCreate(body interface{}) (*httprutils.Response, error) {
+	return lr.PostRolesCreateWithContext(context.Background(), body)
+}
+
+// PostRolesCreateWithContext is the same as PostRolesCreate with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostRolesCreateWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPostReq("/identity/v2/manage/role", body)
 	if err != nil {
 		return nil, err
 	}
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "role.PostRolesCreate", PathTemplate: "/identity/v2/manage/role"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -32,9 +40,16 @@ func (lr Loginradius) PostRolesCreate(body interface{}) (*httprutils.Response, e
 
 // Required template parameter: role - string representing the rolename of the role to be deleted
 func (lr Loginradius) DeleteAccountRole(role string) (*httprutils.Response, error) {
+	return lr.DeleteAccountRoleWithContext(context.Background(), role)
+}
+
+// DeleteAccountRoleWithContext is the same as DeleteAccountRole with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteAccountRoleWithContext(ctx context.Context, role string) (*httprutils.Response, error) {
 	req := lr.Client.NewDeleteReq("/identity/v2/manage/role/" + role)
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "role.DeleteAccountRole", PathTemplate: "/identity/v2/manage/role/{role}"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -44,34 +59,73 @@ func (lr Loginradius) DeleteAccountRole(role string) (*httprutils.Response, erro
 
 // Required template parameter: uid - string representing uid of the user
 func (lr Loginradius) GetContextRolesPermissions(uid string) (*httprutils.Response, error) {
+	return lr.GetContextRolesPermissionsWithContext(context.Background(), uid)
+}
+
+// GetContextRolesPermissionsWithContext is the same as GetContextRolesPermissions with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetContextRolesPermissionsWithContext(ctx context.Context, uid string) (*httprutils.Response, error) {
 	req := lr.Client.NewGetReq("/identity/v2/manage/account/" + uid + "/rolecontext")
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "role.GetContextRolesPermissions", PathTemplate: "/identity/v2/manage/account/{uid}/rolecontext"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
+// GetContextRolesPermissionsTyped is the same as GetContextRolesPermissionsWithContext, returning the response decoded
+// into an lrjson.RoleContextList.
+func (lr Loginradius) GetContextRolesPermissionsTyped(ctx context.Context, uid string) (*lrjson.RoleContextList, error) {
+	return lrjson.Decode[lrjson.RoleContextList](lr.GetContextRolesPermissionsWithContext(ctx, uid))
+}
+
 // GetRolesList retrieves the complete list of created roles with permissions of your app.
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/roles-management/roles-list
 func (lr Loginradius) GetRolesList() (*httprutils.Response, error) {
+	return lr.GetRolesListWithContext(context.Background())
+}
+
+// GetRolesListWithContext is the same as GetRolesList with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetRolesListWithContext(ctx context.Context) (*httprutils.Response, error) {
 	req := lr.Client.NewGetReq("/identity/v2/manage/role")
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "role.GetRolesList", PathTemplate: "/identity/v2/manage/role"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
+// GetRolesListTyped is the same as GetRolesListWithContext, returning the response decoded
+// into an lrjson.RoleList.
+func (lr Loginradius) GetRolesListTyped(ctx context.Context) (*lrjson.RoleList, error) {
+	return lrjson.Decode[lrjson.RoleList](lr.GetRolesListWithContext(ctx))
+}
+
 // GetRolesByUID is used to retrieve all the assigned roles of a particular User.
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/roles-management/get-roles-by-uid
 
 // Required template parameter: uid - string representing user's uid
 func (lr Loginradius) GetRolesByUID(uid string) (*httprutils.Response, error) {
+	return lr.GetRolesByUIDWithContext(context.Background(), uid)
+}
+
+// GetRolesByUIDWithContext is the same as GetRolesByUID with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetRolesByUIDWithContext(ctx context.Context, uid string) (*httprutils.Response, error) {
 	req := lr.Client.NewGetReq("/identity/v2/manage/account/" + uid + "/role")
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "role.GetRolesByUID", PathTemplate: "/identity/v2/manage/account/{uid}/role"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
+// GetRolesByUIDTyped is the same as GetRolesByUIDWithContext, returning the response decoded
+// into an lrjson.UserRoles.
+func (lr Loginradius) GetRolesByUIDTyped(ctx context.Context, uid string) (*lrjson.UserRoles, error) {
+	return lrjson.Decode[lrjson.UserRoles](lr.GetRolesByUIDWithContext(ctx, uid))
+}
+
 // PutAccountAddPermissionsToRole is used to add permissions to the role.
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/roles-management/add-permissions-to-role
@@ -83,12 +137,19 @@ func (lr Loginradius) GetRolesByUID(uid string) (*httprutils.Response, error) {
 // Pass data in struct lrbody.PermissionList as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte could also be passed as body
 func (lr Loginradius) PutAccountAddPermissionsToRole(role string, body interface{}) (*httprutils.Response, error) {
+	return lr.PutAccountAddPermissionsToRoleWithContext(context.Background(), role, body)
+}
+
+// PutAccountAddPermissionsToRoleWithContext is the same as PutAccountAddPermissionsToRole with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutAccountAddPermissionsToRoleWithContext(ctx context.Context, role string, body interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPutReq("/identity/v2/manage/role/"+role+"/permission", body)
 	if err != nil {
 		return nil, err
 	}
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "role.PutAccountAddPermissionsToRole", PathTemplate: "/identity/v2/manage/role/{role}/permission"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -103,12 +164,19 @@ func (lr Loginradius) PutAccountAddPermissionsToRole(role string, body interface
 // Pass data in struct lrbody.RoleList as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte could also be passed as body
 func (lr Loginradius) PutRolesAssignToUser(uid string, body interface{}) (*httprutils.Response, error) {
+	return lr.PutRolesAssignToUserWithContext(context.Background(), uid, body)
+}
+
+// PutRolesAssignToUserWithContext is the same as PutRolesAssignToUser with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutRolesAssignToUserWithContext(ctx context.Context, uid string, body interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPutReq("/identity/v2/manage/account/"+uid+"/role", body)
 	if err != nil {
 		return nil, err
 	}
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "role.PutRolesAssignToUser", PathTemplate: "/identity/v2/manage/account/{uid}/role"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -123,13 +191,19 @@ func (lr Loginradius) PutRolesAssignToUser(uid string, body interface{}) (*httpr
 // Rolecontext object must contain: context - string; roles: array of strings representing role names; additionalpermissions: array of strings
 // representing additional permissions; expiration: date of expiration of role context, format mm/dd/yyyy h:m:s
 func (lr Loginradius) PutRolesUpsertContext(uid string, body interface{}) (*httprutils.Response, error) {
+	return lr.PutRolesUpsertContextWithContext(context.Background(), uid, body)
+}
+
+// PutRolesUpsertContextWithContext is the same as PutRolesUpsertContext with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PutRolesUpsertContextWithContext(ctx context.Context, uid string, body interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPutReq("/identity/v2/manage/account/"+uid+"/rolecontext", body)
 	if err != nil {
 		return nil, err
 	}
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	fmt.Println(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "role.PutRolesUpsertContext", PathTemplate: "/identity/v2/manage/account/{uid}/rolecontext"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -143,10 +217,17 @@ func (lr Loginradius) PutRolesUpsertContext(uid string, body interface{}) (*http
 
 // Sample body parameter: map[string][]string{"roles":[]string{"role1", "role2"}} or []byte{`{"roles":["role1", "role2"]}`}
 func (lr Loginradius) DeleteRolesAssignedToUser(uid string, body interface{}) (*httprutils.Response, error) {
+	return lr.DeleteRolesAssignedToUserWithContext(context.Background(), uid, body)
+}
+
+// DeleteRolesAssignedToUserWithContext is the same as DeleteRolesAssignedToUser with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteRolesAssignedToUserWithContext(ctx context.Context, uid string, body interface{}) (*httprutils.Response, error) {
 	req := lr.Client.NewDeleteReq("/identity/v2/manage/account/"+uid+"/role", body)
 	req.Headers = httprutils.JSONHeader
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "role.DeleteRolesAssignedToUser", PathTemplate: "/identity/v2/manage/account/{uid}/role"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -161,10 +242,17 @@ func (lr Loginradius) DeleteRolesAssignedToUser(uid string, body interface{}) (*
 // Pass data in struct lrbody.PermissionList as body to help ensure parameters satisfy API requirements; alternatively,
 // []byte could also be passed as body
 func (lr Loginradius) DeleteRolesAccountRemovePermissions(roleName string, body interface{}) (*httprutils.Response, error) {
+	return lr.DeleteRolesAccountRemovePermissionsWithContext(context.Background(), roleName, body)
+}
+
+// DeleteRolesAccountRemovePermissionsWithContext is the same as DeleteRolesAccountRemovePermissions with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteRolesAccountRemovePermissionsWithContext(ctx context.Context, roleName string, body interface{}) (*httprutils.Response, error) {
 	req := lr.Client.NewDeleteReq("/identity/v2/manage/role/"+roleName+"/permission", body)
 	req.Headers = httprutils.JSONHeader
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "role.DeleteRolesAccountRemovePermissions", PathTemplate: "/identity/v2/manage/role/{role}/permission"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -175,10 +263,17 @@ func (lr Loginradius) DeleteRolesAccountRemovePermissions(roleName string, body
 // Required template parameter: uid - string preresenting user's uid; rolecontextname - string representing the name of the role context
 // to be deleted
 func (lr Loginradius) DeleteContextFromRole(uid, rolecontextname string) (*httprutils.Response, error) {
+	return lr.DeleteContextFromRoleWithContext(context.Background(), uid, rolecontextname)
+}
+
+// DeleteContextFromRoleWithContext is the same as DeleteContextFromRole with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteContextFromRoleWithContext(ctx context.Context, uid, rolecontextname string) (*httprutils.Response, error) {
 	req := lr.Client.NewDeleteReq("/identity/v2/manage/account/" + uid + "/rolecontext/" + rolecontextname)
 	req.Headers = httprutils.JSONHeader
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "role.DeleteContextFromRole", PathTemplate: "/identity/v2/manage/account/{uid}/rolecontext/{rolecontextname}"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -191,10 +286,17 @@ func (lr Loginradius) DeleteContextFromRole(uid, rolecontextname string) (*httpr
 
 // Required body parameters: roles - array of strings representing the role name(s) to be deleted
 func (lr Loginradius) DeleteRoleFromContext(uid, rolecontextname string, body interface{}) (*httprutils.Response, error) {
+	return lr.DeleteRoleFromContextWithContext(context.Background(), uid, rolecontextname, body)
+}
+
+// DeleteRoleFromContextWithContext is the same as DeleteRoleFromContext with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteRoleFromContextWithContext(ctx context.Context, uid, rolecontextname string, body interface{}) (*httprutils.Response, error) {
 	req := lr.Client.NewDeleteReq("/identity/v2/manage/account/"+uid+"/rolecontext/"+rolecontextname+"/role", body)
 	req.Headers = httprutils.JSONHeader
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "role.DeleteRoleFromContext", PathTemplate: "/identity/v2/manage/account/{uid}/rolecontext/{rolecontextname}/role"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -207,9 +309,16 @@ func (lr Loginradius) DeleteRoleFromContext(uid, rolecontextname string, body in
 
 // Required post parameters: additionalpermissions - array of strings representing names of additional permissions
 func (lr Loginradius) DeleteAdditionalPermissionFromContext(uid, rolecontextname string, body interface{}) (*httprutils.Response, error) {
+	return lr.DeleteAdditionalPermissionFromContextWithContext(context.Background(), uid, rolecontextname, body)
+}
+
+// DeleteAdditionalPermissionFromContextWithContext is the same as DeleteAdditionalPermissionFromContext with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteAdditionalPermissionFromContextWithContext(ctx context.Context, uid, rolecontextname string, body interface{}) (*httprutils.Response, error) {
 	req := lr.Client.NewDeleteReq("/identity/v2/manage/account/"+uid+"/rolecontext/"+rolecontextname+"/additionalpermission", body)
 	req.Headers = httprutils.JSONHeader
 	lr.Client.AddApiCredentialsToReqHeader(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "role.DeleteAdditionalPermissionFromContext", PathTemplate: "/identity/v2/manage/account/{uid}/rolecontext/{rolecontextname}/additionalpermission"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
@@ -1,6 +1,8 @@
 package smartlogin
 
 import (
+	"context"
+
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
 
 	"github.com/LoginRadius/go-sdk/httprutils"
@@ -11,6 +13,12 @@ import (
 // Required query parameters: apikey, email, clientguid
 // Optional query parameters: smartloginemailtemplate, welcomeemailtemplate, redirecturl
 func (lr Loginradius) GetSmartLoginByEmail(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetSmartLoginByEmailWithContext(context.Background(), queries)
+}
+
+// GetSmartLoginByEmailWithContext is the same as GetSmartLoginByEmail with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSmartLoginByEmailWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"email": true, "clientguid": true, "smartloginemailtemplate": true, "welcomeemailtemplate": true, "redirecturl": true,
 	}
@@ -20,7 +28,8 @@ func (lr Loginradius) GetSmartLoginByEmail(queries interface{}) (*httprutils.Res
 	}
 	req := lr.Client.NewGetReq("/identity/v2/auth/login/smartlogin", validatedQueries)
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "smartlogin.GetSmartLoginByEmail", PathTemplate: "/identity/v2/auth/login/smartlogin"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -29,6 +38,12 @@ func (lr Loginradius) GetSmartLoginByEmail(queries interface{}) (*httprutils.Res
 // Required query parameters: apikey, username, clientguid
 // Optional query parameters: smartloginemailtemplate, welcomeemailtemplate, redirecturl
 func (lr Loginradius) GetSmartLoginByUsername(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetSmartLoginByUsernameWithContext(context.Background(), queries)
+}
+
+// GetSmartLoginByUsernameWithContext is the same as GetSmartLoginByUsername with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSmartLoginByUsernameWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"username": true, "clientguid": true, "smartloginemailtemplate": true, "welcomeemailtemplate": true, "redirecturl": true,
 	}
@@ -38,7 +53,8 @@ func (lr Loginradius) GetSmartLoginByUsername(queries interface{}) (*httprutils.
 	}
 	req := lr.Client.NewGetReq("/identity/v2/auth/login/smartlogin", validatedQueries)
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "smartlogin.GetSmartLoginByUsername", PathTemplate: "/identity/v2/auth/login/smartlogin"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -46,6 +62,12 @@ func (lr Loginradius) GetSmartLoginByUsername(queries interface{}) (*httprutils.
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/smart-login/smart-login-ping
 // Required query parameters: apikey, clientguid
 func (lr Loginradius) GetSmartLoginPing(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetSmartLoginPingWithContext(context.Background(), queries)
+}
+
+// GetSmartLoginPingWithContext is the same as GetSmartLoginPing with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSmartLoginPingWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"clientguid": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -53,15 +75,22 @@ func (lr Loginradius) GetSmartLoginPing(queries interface{}) (*httprutils.Respon
 	}
 	req := lr.Client.NewGetReq("/identity/v2/auth/login/smartlogin/ping", validatedQueries)
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "smartlogin.GetSmartLoginPing", PathTemplate: "/identity/v2/auth/login/smartlogin/ping"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
 // GetSmartLoginVerifyToken verifies the provided token for Smart Login.
-// Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/smart-login/smart-login-verify-token
+//  Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/smart-login/smart-login-verify-token
 // Required query parameterS: apikey, verificationtoken,
 // Optional query parameters: welcommeemailtemplate
 func (lr Loginradius) GetSmartLoginVerifyToken(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetSmartLoginVerifyTokenWithContext(context.Background(), queries)
+}
+
+// GetSmartLoginVerifyTokenWithContext is the same as GetSmartLoginVerifyToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSmartLoginVerifyTokenWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"clientguid": true, "verificationtoken": true, "welcomeemailtemplate": true,
 	}
@@ -71,6 +100,7 @@ func (lr Loginradius) GetSmartLoginVerifyToken(queries interface{}) (*httprutils
 	}
 	req := lr.Client.NewGetReq("/identity/v2/auth/email/smartlogin", validatedQueries)
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "smartlogin.GetSmartLoginVerifyToken", PathTemplate: "/identity/v2/auth/email/smartlogin"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
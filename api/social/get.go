@@ -1,6 +1,7 @@
 package lrsocial
 
 import (
+	"context"
 	"errors"
 
 	"github.com/LoginRadius/go-sdk/httprutils"
@@ -17,12 +18,19 @@ import (
 
 // For more information on the LoginRadius request token: https://www.loginradius.com/docs/infrastructure-and-security/loginradius-tokens#loginradius-request-token-expiration-15-mins-
 func (lr Loginradius) GetSocialAccessToken(requestToken string) (*httprutils.Response, error) {
+	return lr.GetSocialAccessTokenWithContext(context.Background(), requestToken)
+}
+
+// GetSocialAccessTokenWithContext is the same as GetSocialAccessToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialAccessTokenWithContext(ctx context.Context, requestToken string) (*httprutils.Response, error) {
 	req := lr.Client.NewGetReq("/api/v2/access_token", map[string]string{
 		"token":  requestToken,
 		"secret": lr.Client.Context.ApiSecret,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialAccessToken", PathTemplate: "/api/v2/access_token"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -32,9 +40,15 @@ func (lr Loginradius) GetSocialAccessToken(requestToken string) (*httprutils.Res
 
 // Required query params: key - string ; secret - string; access_token - string
 func (lr Loginradius) GetSocialTokenValidate() (*httprutils.Response, error) {
+	return lr.GetSocialTokenValidateWithContext(context.Background())
+}
+
+// GetSocialTokenValidateWithContext is the same as GetSocialTokenValidate with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialTokenValidateWithContext(ctx context.Context) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 	req := lr.Client.NewGetReq("/api/v2/access_token/validate", map[string]string{
@@ -43,7 +57,8 @@ func (lr Loginradius) GetSocialTokenValidate() (*httprutils.Response, error) {
 		"access_token": lr.Client.Context.Token,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialTokenValidate", PathTemplate: "/api/v2/access_token/validate"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -55,9 +70,15 @@ func (lr Loginradius) GetSocialTokenValidate() (*httprutils.Response, error) {
 
 // Optional Parameters: preventRefresh - string (takes true or false)
 func (lr Loginradius) GetSocialTokenInvalidate(queries ...interface{}) (*httprutils.Response, error) {
+	return lr.GetSocialTokenInvalidateWithContext(context.Background(), queries...)
+}
+
+// GetSocialTokenInvalidateWithContext is the same as GetSocialTokenInvalidate with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialTokenInvalidateWithContext(ctx context.Context, queries ...interface{}) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -79,7 +100,8 @@ func (lr Loginradius) GetSocialTokenInvalidate(queries ...interface{}) (*httprut
 		}
 	}
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialTokenInvalidate", PathTemplate: "/api/v2/access_token/invalidate"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -94,9 +116,15 @@ func (lr Loginradius) GetSocialTokenInvalidate(queries ...interface{}) (*httprut
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/album
 func (lr Loginradius) GetSocialAlbum() (*httprutils.Response, error) {
+	return lr.GetSocialAlbumWithContext(context.Background())
+}
+
+// GetSocialAlbumWithContext is the same as GetSocialAlbum with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialAlbumWithContext(ctx context.Context) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -104,7 +132,8 @@ func (lr Loginradius) GetSocialAlbum() (*httprutils.Response, error) {
 		"access_token": lr.Client.Context.Token,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialAlbum", PathTemplate: "/api/v2/album"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -119,9 +148,15 @@ func (lr Loginradius) GetSocialAlbum() (*httprutils.Response, error) {
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/audio
 func (lr Loginradius) GetSocialAudio() (*httprutils.Response, error) {
+	return lr.GetSocialAudioWithContext(context.Background())
+}
+
+// GetSocialAudioWithContext is the same as GetSocialAudio with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialAudioWithContext(ctx context.Context) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -129,7 +164,8 @@ func (lr Loginradius) GetSocialAudio() (*httprutils.Response, error) {
 		"access_token": lr.Client.Context.Token,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialAudio", PathTemplate: "/api/v2/audio"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -144,9 +180,15 @@ func (lr Loginradius) GetSocialAudio() (*httprutils.Response, error) {
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/check-in
 func (lr Loginradius) GetSocialCheckin() (*httprutils.Response, error) {
+	return lr.GetSocialCheckinWithContext(context.Background())
+}
+
+// GetSocialCheckinWithContext is the same as GetSocialCheckin with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialCheckinWithContext(ctx context.Context) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -154,7 +196,8 @@ func (lr Loginradius) GetSocialCheckin() (*httprutils.Response, error) {
 		"access_token": lr.Client.Context.Token,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialCheckin", PathTemplate: "/api/v2/checkin"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -167,9 +210,15 @@ func (lr Loginradius) GetSocialCheckin() (*httprutils.Response, error) {
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/company
 func (lr Loginradius) GetSocialCompany() (*httprutils.Response, error) {
+	return lr.GetSocialCompanyWithContext(context.Background())
+}
+
+// GetSocialCompanyWithContext is the same as GetSocialCompany with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialCompanyWithContext(ctx context.Context) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -177,7 +226,8 @@ func (lr Loginradius) GetSocialCompany() (*httprutils.Response, error) {
 		"access_token": lr.Client.Context.Token,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialCompany", PathTemplate: "/api/v2/company"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -195,9 +245,15 @@ func (lr Loginradius) GetSocialCompany() (*httprutils.Response, error) {
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/contact
 func (lr Loginradius) GetSocialContact() (*httprutils.Response, error) {
+	return lr.GetSocialContactWithContext(context.Background())
+}
+
+// GetSocialContactWithContext is the same as GetSocialContact with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialContactWithContext(ctx context.Context) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -205,7 +261,8 @@ func (lr Loginradius) GetSocialContact() (*httprutils.Response, error) {
 		"access_token": lr.Client.Context.Token,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialContact", PathTemplate: "/api/v2/contact"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -216,9 +273,15 @@ func (lr Loginradius) GetSocialContact() (*httprutils.Response, error) {
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/event
 func (lr Loginradius) GetSocialEvent() (*httprutils.Response, error) {
+	return lr.GetSocialEventWithContext(context.Background())
+}
+
+// GetSocialEventWithContext is the same as GetSocialEvent with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialEventWithContext(ctx context.Context) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -226,7 +289,8 @@ func (lr Loginradius) GetSocialEvent() (*httprutils.Response, error) {
 		"access_token": lr.Client.Context.Token,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialEvent", PathTemplate: "/api/v2/event"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -240,9 +304,15 @@ func (lr Loginradius) GetSocialEvent() (*httprutils.Response, error) {
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/following
 func (lr Loginradius) GetSocialFollowing() (*httprutils.Response, error) {
+	return lr.GetSocialFollowingWithContext(context.Background())
+}
+
+// GetSocialFollowingWithContext is the same as GetSocialFollowing with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialFollowingWithContext(ctx context.Context) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -250,7 +320,8 @@ func (lr Loginradius) GetSocialFollowing() (*httprutils.Response, error) {
 		"access_token": lr.Client.Context.Token,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialFollowing", PathTemplate: "/api/v2/following"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -264,9 +335,15 @@ func (lr Loginradius) GetSocialFollowing() (*httprutils.Response, error) {
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/group
 func (lr Loginradius) GetSocialGroup() (*httprutils.Response, error) {
+	return lr.GetSocialGroupWithContext(context.Background())
+}
+
+// GetSocialGroupWithContext is the same as GetSocialGroup with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialGroupWithContext(ctx context.Context) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -274,7 +351,8 @@ func (lr Loginradius) GetSocialGroup() (*httprutils.Response, error) {
 		"access_token": lr.Client.Context.Token,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialGroup", PathTemplate: "/api/v2/group"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -287,9 +365,15 @@ func (lr Loginradius) GetSocialGroup() (*httprutils.Response, error) {
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/like
 func (lr Loginradius) GetSocialLike() (*httprutils.Response, error) {
+	return lr.GetSocialLikeWithContext(context.Background())
+}
+
+// GetSocialLikeWithContext is the same as GetSocialLike with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialLikeWithContext(ctx context.Context) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -297,7 +381,8 @@ func (lr Loginradius) GetSocialLike() (*httprutils.Response, error) {
 		"access_token": lr.Client.Context.Token,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialLike", PathTemplate: "/api/v2/like"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -310,9 +395,15 @@ func (lr Loginradius) GetSocialLike() (*httprutils.Response, error) {
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/mention
 func (lr Loginradius) GetSocialMention() (*httprutils.Response, error) {
+	return lr.GetSocialMentionWithContext(context.Background())
+}
+
+// GetSocialMentionWithContext is the same as GetSocialMention with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialMentionWithContext(ctx context.Context) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -320,7 +411,8 @@ func (lr Loginradius) GetSocialMention() (*httprutils.Response, error) {
 		"access_token": lr.Client.Context.Token,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialMention", PathTemplate: "/api/v2/mention"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -334,6 +426,12 @@ func (lr Loginradius) GetSocialMention() (*httprutils.Response, error) {
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/get-message-api
 func (lr Loginradius) GetSocialStatusPost(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetSocialStatusPostWithContext(context.Background(), queries)
+}
+
+// GetSocialStatusPostWithContext is the same as GetSocialStatusPost with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialStatusPostWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"url": true, "title": true, "imageurl": true, "status": true, "caption": true, "description": true,
 	}
@@ -345,7 +443,7 @@ func (lr Loginradius) GetSocialStatusPost(queries interface{}) (*httprutils.Resp
 
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -353,12 +451,11 @@ func (lr Loginradius) GetSocialStatusPost(queries interface{}) (*httprutils.Resp
 
 	request := lr.Client.NewGetReq("/api/v2/status/js", validatedQueries)
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialStatusPost", PathTemplate: "/api/v2/status/js"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
-
-
 // GetSocialPage is used to get the page data from the user’s social account.
 // Supported Providers: Facebook, LinkedIn
 
@@ -368,9 +465,15 @@ func (lr Loginradius) GetSocialStatusPost(queries interface{}) (*httprutils.Resp
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/page
 func (lr Loginradius) GetSocialPage(pagename string) (*httprutils.Response, error) {
+	return lr.GetSocialPageWithContext(context.Background(), pagename)
+}
+
+// GetSocialPageWithContext is the same as GetSocialPage with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialPageWithContext(ctx context.Context, pagename string) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -378,7 +481,8 @@ func (lr Loginradius) GetSocialPage(pagename string) (*httprutils.Response, erro
 		"access_token": lr.Client.Context.Token, "pagename": pagename,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialPage", PathTemplate: "/api/v2/page"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -392,9 +496,15 @@ func (lr Loginradius) GetSocialPage(pagename string) (*httprutils.Response, erro
 
 // Documentation: https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/photo
 func (lr Loginradius) GetSocialPhoto(albumid string) (*httprutils.Response, error) {
+	return lr.GetSocialPhotoWithContext(context.Background(), albumid)
+}
+
+// GetSocialPhotoWithContext is the same as GetSocialPhoto with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialPhotoWithContext(ctx context.Context, albumid string) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -402,7 +512,8 @@ func (lr Loginradius) GetSocialPhoto(albumid string) (*httprutils.Response, erro
 		"access_token": lr.Client.Context.Token, "albumid": albumid,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialPhoto", PathTemplate: "/api/v2/photo"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -416,9 +527,15 @@ func (lr Loginradius) GetSocialPhoto(albumid string) (*httprutils.Response, erro
 
 // Documentation - https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/post
 func (lr Loginradius) GetSocialPost() (*httprutils.Response, error) {
+	return lr.GetSocialPostWithContext(context.Background())
+}
+
+// GetSocialPostWithContext is the same as GetSocialPost with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialPostWithContext(ctx context.Context) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -426,7 +543,8 @@ func (lr Loginradius) GetSocialPost() (*httprutils.Response, error) {
 		"access_token": lr.Client.Context.Token,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialPost", PathTemplate: "/api/v2/post"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -440,9 +558,15 @@ func (lr Loginradius) GetSocialPost() (*httprutils.Response, error) {
 
 // Documentation - https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/status-fetching
 func (lr Loginradius) GetSocialStatus() (*httprutils.Response, error) {
+	return lr.GetSocialStatusWithContext(context.Background())
+}
+
+// GetSocialStatusWithContext is the same as GetSocialStatus with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialStatusWithContext(ctx context.Context) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -450,7 +574,8 @@ func (lr Loginradius) GetSocialStatus() (*httprutils.Response, error) {
 		"access_token": lr.Client.Context.Token,
 	})
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialStatus", PathTemplate: "/api/v2/status"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
 
@@ -464,9 +589,15 @@ func (lr Loginradius) GetSocialStatus() (*httprutils.Response, error) {
 
 // Documentation - https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/advanced-social-api/video
 func (lr Loginradius) GetSocialVideo(queries ...interface{}) (*httprutils.Response, error) {
+	return lr.GetSocialVideoWithContext(context.Background(), queries...)
+}
+
+// GetSocialVideoWithContext is the same as GetSocialVideo with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetSocialVideoWithContext(ctx context.Context, queries ...interface{}) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -486,6 +617,7 @@ func (lr Loginradius) GetSocialVideo(queries ...interface{}) (*httprutils.Respon
 		}
 	}
 
-	resp, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "lrsocial.GetSocialVideo", PathTemplate: "/api/v2/video"}
+	resp, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return resp, err
 }
@@ -1,6 +1,7 @@
 package lrsocial
 
 import (
+	"context"
 	"errors"
 
 	"github.com/LoginRadius/go-sdk/httprutils"
@@ -19,6 +20,12 @@ import (
 
 // Required query parameters: to - string; subject - string; message - string; access_token - string
 func (lr Loginradius) PostSocialMessageAPI(queries interface{}) (*httprutils.Response, error) {
+	return lr.PostSocialMessageAPIWithContext(context.Background(), queries)
+}
+
+// PostSocialMessageAPIWithContext is the same as PostSocialMessageAPI with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostSocialMessageAPIWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"to": true, "subject": true, "message": true,
 	}
@@ -29,7 +36,7 @@ func (lr Loginradius) PostSocialMessageAPI(queries interface{}) (*httprutils.Res
 
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -42,7 +49,8 @@ func (lr Loginradius) PostSocialMessageAPI(queries interface{}) (*httprutils.Res
 
 	request.Headers = httprutils.URLEncodedHeader
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrsocial.PostSocialMessageAPI", PathTemplate: "/api/v2/message"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
 
@@ -53,6 +61,12 @@ func (lr Loginradius) PostSocialMessageAPI(queries interface{}) (*httprutils.Res
 
 // Required query parameters: url - string; title - string; imageurl-string; status-string; caption - string; description - string;
 func (lr Loginradius) PostSocialStatusPost(queries interface{}) (*httprutils.Response, error) {
+	return lr.PostSocialStatusPostWithContext(context.Background(), queries)
+}
+
+// PostSocialStatusPostWithContext is the same as PostSocialStatusPost with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostSocialStatusPostWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{
 		"url": true, "title": true, "imageurl": true, "status": true, "caption": true, "description": true,
 	}
@@ -64,7 +78,7 @@ func (lr Loginradius) PostSocialStatusPost(queries interface{}) (*httprutils.Res
 
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -77,6 +91,7 @@ func (lr Loginradius) PostSocialStatusPost(queries interface{}) (*httprutils.Res
 
 	request.Headers = httprutils.URLEncodedHeader
 
-	response, err := lr.Client.HTTPRClient.Send(*request)
+	request.Endpoint = httprutils.Endpoint{Operation: "lrsocial.PostSocialStatusPost", PathTemplate: "/api/v2/status"}
+	response, err := lr.Client.HTTPRClient.SendWithContext(ctx, *request)
 	return response, err
 }
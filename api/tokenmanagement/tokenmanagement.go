@@ -1,6 +1,7 @@
 package tokenmanagement
 
 import (
+	"context"
 	"errors"
 
 	"github.com/LoginRadius/go-sdk/httprutils"
@@ -13,6 +14,12 @@ import (
 // Documentation https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/native-social-login-api/access-token-via-facebook-token
 // Required query parameter: key, fb_access_token
 func (lr Loginradius) GetAccessTokenViaFacebook(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetAccessTokenViaFacebookWithContext(context.Background(), queries)
+}
+
+// GetAccessTokenViaFacebookWithContext is the same as GetAccessTokenViaFacebook with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAccessTokenViaFacebookWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"fb_access_token": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -22,7 +29,8 @@ func (lr Loginradius) GetAccessTokenViaFacebook(queries interface{}) (*httprutil
 	req := lr.Client.NewGetReq("/api/v2/access_token/facebook", validatedQueries)
 
 	delete(req.QueryParams, "apiKey")
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "tokenmanagement.GetAccessTokenViaFacebook", PathTemplate: "/api/v2/access_token/facebook"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -31,6 +39,12 @@ func (lr Loginradius) GetAccessTokenViaFacebook(queries interface{}) (*httprutil
 // Documentation https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/native-social-login-api/access-token-via-twitter-token
 // Required query parameter: key, tw_access_token, tw_token_secret
 func (lr Loginradius) GetAccessTokenViaTwitter(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetAccessTokenViaTwitterWithContext(context.Background(), queries)
+}
+
+// GetAccessTokenViaTwitterWithContext is the same as GetAccessTokenViaTwitter with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAccessTokenViaTwitterWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"tw_access_token": true, "tw_token_secret": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -40,7 +54,8 @@ func (lr Loginradius) GetAccessTokenViaTwitter(queries interface{}) (*httprutils
 	req := lr.Client.NewGetReq("/api/v2/access_token/twitter", validatedQueries)
 
 	delete(req.QueryParams, "apiKey")
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "tokenmanagement.GetAccessTokenViaTwitter", PathTemplate: "/api/v2/access_token/twitter"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -49,6 +64,12 @@ func (lr Loginradius) GetAccessTokenViaTwitter(queries interface{}) (*httprutils
 // Documentation https://www.loginradius.com/docs/api/v2/customer-identity-api/social-login/native-social-login-api/access-token-via-vkontakte-token
 // Required query parameter: key, vk_access_token
 func (lr Loginradius) GetAccessTokenViaVkontakte(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetAccessTokenViaVkontakteWithContext(context.Background(), queries)
+}
+
+// GetAccessTokenViaVkontakteWithContext is the same as GetAccessTokenViaVkontakte with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetAccessTokenViaVkontakteWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"vk_access_token": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -58,7 +79,8 @@ func (lr Loginradius) GetAccessTokenViaVkontakte(queries interface{}) (*httpruti
 	req := lr.Client.NewGetReq("/api/v2/access_token/vkontakte", validatedQueries)
 
 	delete(req.QueryParams, "apiKey")
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "tokenmanagement.GetAccessTokenViaVkontakte", PathTemplate: "/api/v2/access_token/vkontakte"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -70,15 +92,22 @@ func (lr Loginradius) GetAccessTokenViaVkontakte(queries interface{}) (*httpruti
 // Documentation:https://www.loginradius.com/docs/api/v2/customer-identity-api/refresh-token/refresh-user-profile
 // Required query parameter: access_token
 func (lr Loginradius) GetRefreshUserProfile() (*httprutils.Response, error) {
+	return lr.GetRefreshUserProfileWithContext(context.Background())
+}
+
+// GetRefreshUserProfileWithContext is the same as GetRefreshUserProfile with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetRefreshUserProfileWithContext(ctx context.Context) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
 	req := lr.Client.NewGetReq("/api/v2/userprofile/refresh")
 	req.QueryParams = map[string]string{"access_token": lr.Client.Context.Token}
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "tokenmanagement.GetRefreshUserProfile", PathTemplate: "/api/v2/userprofile/refresh"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -91,9 +120,15 @@ func (lr Loginradius) GetRefreshUserProfile() (*httprutils.Response, error) {
 // Required query parameters: access_token, secret
 // Optional query parameter: expiresin (Allows you to specify a desired expiration time in minutes for the newly issued access_token.)
 func (lr Loginradius) GetRefreshToken(queries ...interface{}) (*httprutils.Response, error) {
+	return lr.GetRefreshTokenWithContext(context.Background(), queries...)
+}
+
+// GetRefreshTokenWithContext is the same as GetRefreshToken with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetRefreshTokenWithContext(ctx context.Context, queries ...interface{}) (*httprutils.Response, error) {
 	if lr.Client.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -115,7 +150,7 @@ func (lr Loginradius) GetRefreshToken(queries ...interface{}) (*httprutils.Respo
 
 	req := lr.Client.NewGetReq("/api/v2/access_token/refresh", queryParams)
 	delete(req.QueryParams, "apiKey")
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "tokenmanagement.GetRefreshToken", PathTemplate: "/api/v2/access_token/refresh"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
-
@@ -1,6 +1,8 @@
 package webhook
 
 import (
+	"context"
+
 	"github.com/LoginRadius/go-sdk/httprutils"
 	lrvalidate "github.com/LoginRadius/go-sdk/internal/validate"
 )
@@ -13,13 +15,20 @@ import (
 // Required post parameters: TargetUrl - string; Event - string
 // For a list of all supported values for the Event parameter see documentation
 func (lr Loginradius) PostWebhookSubscribe(body interface{}) (*httprutils.Response, error) {
+	return lr.PostWebhookSubscribeWithContext(context.Background(), body)
+}
+
+// PostWebhookSubscribeWithContext is the same as PostWebhookSubscribe with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) PostWebhookSubscribeWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	req, err := lr.Client.NewPostReq("/api/v2/webhook", body)
 	if err != nil {
 		return nil, err
 	}
 	lr.Client.NormalizeApiKey(req)
 	req.QueryParams["apisecret"] = lr.Client.Context.ApiSecret
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "webhook.PostWebhookSubscribe", PathTemplate: "/api/v2/webhook"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -27,10 +36,17 @@ func (lr Loginradius) PostWebhookSubscribe(body interface{}) (*httprutils.Respon
 // Documentation https://www.loginradius.com/docs/api/v2/integrations/webhooks/webhook-test
 // Required query parameters: apikey, apisecret
 func (lr Loginradius) GetWebhookTest() (*httprutils.Response, error) {
+	return lr.GetWebhookTestWithContext(context.Background())
+}
+
+// GetWebhookTestWithContext is the same as GetWebhookTest with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetWebhookTestWithContext(ctx context.Context) (*httprutils.Response, error) {
 	req := lr.Client.NewGetReq("/api/v2/webhook/test")
 	lr.Client.NormalizeApiKey(req)
 	req.QueryParams["apisecret"] = lr.Client.Context.ApiSecret
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "webhook.GetWebhookTest", PathTemplate: "/api/v2/webhook/test"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -39,6 +55,12 @@ func (lr Loginradius) GetWebhookTest() (*httprutils.Response, error) {
 // Required query parameters: apikey, apisecret, event
 // For a list of all supported values for the Event parameter see documentation
 func (lr Loginradius) GetWebhookSubscribedURLs(queries interface{}) (*httprutils.Response, error) {
+	return lr.GetWebhookSubscribedURLsWithContext(context.Background(), queries)
+}
+
+// GetWebhookSubscribedURLsWithContext is the same as GetWebhookSubscribedURLs with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) GetWebhookSubscribedURLsWithContext(ctx context.Context, queries interface{}) (*httprutils.Response, error) {
 	allowedQueries := map[string]bool{"event": true}
 	validatedQueries, err := lrvalidate.Validate(allowedQueries, queries)
 	if err != nil {
@@ -47,7 +69,8 @@ func (lr Loginradius) GetWebhookSubscribedURLs(queries interface{}) (*httprutils
 	validatedQueries["apisecret"] = lr.Client.Context.ApiSecret
 	req := lr.Client.NewGetReq("/api/v2/webhook", validatedQueries)
 	lr.Client.NormalizeApiKey(req)
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "webhook.GetWebhookSubscribedURLs", PathTemplate: "/api/v2/webhook"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
 
@@ -57,12 +80,19 @@ func (lr Loginradius) GetWebhookSubscribedURLs(queries interface{}) (*httprutils
 // Required query parameters: apikey, apisecret
 // Required post parameters: targeturl - string, event - string
 func (lr Loginradius) DeleteWebhookUnsubscribe(body interface{}) (*httprutils.Response, error) {
+	return lr.DeleteWebhookUnsubscribeWithContext(context.Background(), body)
+}
+
+// DeleteWebhookUnsubscribeWithContext is the same as DeleteWebhookUnsubscribe with the addition of
+// the ability to pass a context for request cancellation and deadlines.
+func (lr Loginradius) DeleteWebhookUnsubscribeWithContext(ctx context.Context, body interface{}) (*httprutils.Response, error) {
 	req := lr.Client.NewDeleteReq("/api/v2/webhook", body)
 	req.QueryParams = map[string]string{
 		"apisecret": lr.Client.Context.ApiSecret,
 		"apikey":    lr.Client.Context.ApiKey,
 	}
 	req.Headers = httprutils.JSONHeader
-	res, err := lr.Client.HTTPRClient.Send(*req)
+	req.Endpoint = httprutils.Endpoint{Operation: "webhook.DeleteWebhookUnsubscribe", PathTemplate: "/api/v2/webhook"}
+	res, err := lr.Client.HTTPRClient.SendWithContext(ctx, *req)
 	return res, err
 }
@@ -47,7 +47,16 @@ func Signup(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	startTime := ""
 	endTime := ""
 	timeDifference := ""
-	sott := sott.Generate(lrclient.Context.ApiKey, lrclient.Context.ApiSecret, timeDifference, startTime, endTime)
+	sott, err := sott.Generate(lrclient.Context.ApiKey, lrclient.Context.ApiSecret, timeDifference, startTime, endTime)
+	if err != nil {
+		// Registering without a SOTT would only be rejected by LoginRadius.
+		errors = errors + err.(lrerror.Error).OrigErr().Error()
+		log.Print(errors)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(500)
+		w.Write([]byte(errors))
+		return
+	}
 
 	res, err := lrauthentication.Loginradius(lrauthentication.Loginradius{lrclient}).PostAuthUserRegistrationByEmail(
 		sott, user,
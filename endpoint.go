@@ -0,0 +1,100 @@
+package loginradius
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+// An APIFamily groups the LoginRadius APIs served from the same base URL.
+type APIFamily string
+
+const (
+	// IdentityAPI is the family of the /identity/v2 APIs.
+	IdentityAPI APIFamily = "identity"
+
+	// ConfigAPI is the family of the Get Configurations API, served by the configuration CDN.
+	ConfigAPI APIFamily = "config"
+
+	// SocialAPI is the family of the /api/v2 social and access token APIs.
+	SocialAPI APIFamily = "social"
+
+	// WebhookAPI is the family of the /api/v2/webhook APIs.
+	WebhookAPI APIFamily = "webhook"
+)
+
+// APIFamilies lists every API family.
+var APIFamilies = []APIFamily{IdentityAPI, ConfigAPI, SocialAPI, WebhookAPI}
+
+// FamilyOf returns the API family of a request path.
+func FamilyOf(path string) APIFamily {
+	switch {
+	case strings.HasPrefix(path, "/ciam/"):
+		return ConfigAPI
+	case strings.HasPrefix(path, "/api/v2/webhook"):
+		return WebhookAPI
+	case strings.HasPrefix(path, "/api/v2/"):
+		return SocialAPI
+	default:
+		return IdentityAPI
+	}
+}
+
+// An EndpointResolver maps API families to the base URL their calls are sent to, e.g. to
+// send them to a custom domain, a regional data center or a local stub.
+// ResolveEndpoint returns "" for the families it does not override, which are sent to the
+// client's Domain, or ConfigURL for the ConfigAPI family.
+type EndpointResolver interface {
+	ResolveEndpoint(family APIFamily) string
+}
+
+// Endpoints is an EndpointResolver mapping API families to fixed base URLs:
+//
+//	lr.Endpoints{lr.IdentityAPI: "https://auth.example.com", lr.ConfigAPI: "https://config.example.com"}
+type Endpoints map[APIFamily]string
+
+// ResolveEndpoint returns the base URL of family.
+func (e Endpoints) ResolveEndpoint(family APIFamily) string {
+	return e[family]
+}
+
+// BaseURL returns the base URL calls of the given API family are sent to.
+func (lr Loginradius) BaseURL(family APIFamily) string {
+	if lr.Resolver != nil {
+		if base := lr.Resolver.ResolveEndpoint(family); base != "" {
+			return strings.TrimRight(base, "/")
+		}
+	}
+	if family == ConfigAPI {
+		if lr.ConfigURL == "" {
+			return DefaultConfigURL
+		}
+		return lr.ConfigURL
+	}
+	return lr.Domain
+}
+
+// EndpointURL returns the URL of a LoginRadius API path, on the base URL of its API family
+func (lr Loginradius) EndpointURL(path string) string {
+	return lr.BaseURL(FamilyOf(path)) + path
+}
+
+// validateEndpoints checks that the base URL of every API family is an absolute URL,
+// using HTTPS unless the client allows plain HTTP.
+func (lr Loginradius) validateEndpoints() error {
+	for _, family := range APIFamilies {
+		base := lr.BaseURL(family)
+		parsed, err := url.Parse(base)
+		if err != nil || parsed.Host == "" || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+			return lrerror.New(lrerror.CodeInitialization, "Invalid Loginradius client option",
+				errors.New("base URL of the "+string(family)+" APIs must be an absolute http or https URL, got \""+base+"\""))
+		}
+		if parsed.Scheme != "https" && !lr.AllowHTTP {
+			return lrerror.New(lrerror.CodeInitialization, "Invalid Loginradius client option",
+				errors.New("base URL of the "+string(family)+" APIs must use HTTPS, got \""+base+"\"; use WithAllowHTTP to allow plain HTTP"))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package loginradius
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+func TestFamilyOf(t *testing.T) {
+	cases := map[string]APIFamily{
+		"/identity/v2/auth/login":      IdentityAPI,
+		"/ciam/appinfo":                ConfigAPI,
+		"/api/v2/access_token":         SocialAPI,
+		"/api/v2/userprofile":          SocialAPI,
+		"/api/v2/webhook/test":         WebhookAPI,
+		"/identity/v2/manage/role/abc": IdentityAPI,
+	}
+	for path, family := range cases {
+		if got := FamilyOf(path); got != family {
+			t.Errorf("Expected %s to belong to the %s APIs, got %s", path, family, got)
+		}
+	}
+}
+
+func TestEndpointResolver(t *testing.T) {
+	lrclient, err := New(&config, WithEndpointResolver(Endpoints{
+		IdentityAPI: "https://auth.example.com/",
+		WebhookAPI:  "https://hooks.example.com",
+	}))
+	if err != nil {
+		t.Fatalf("Expected the resolver to be accepted, got: %v", err)
+	}
+
+	cases := map[string]string{
+		"/identity/v2/auth/login": "https://auth.example.com/identity/v2/auth/login",
+		"/api/v2/webhook":         "https://hooks.example.com/api/v2/webhook",
+		"/api/v2/access_token":    domain + "/api/v2/access_token",
+		"/ciam/appinfo":           DefaultConfigURL + "/ciam/appinfo",
+	}
+	for path, expected := range cases {
+		if req := lrclient.NewGetReq(path); req.URL != expected {
+			t.Errorf("Expected %s to be sent to %s, got %s", path, expected, req.URL)
+		}
+	}
+}
+
+func TestNewEnforcesHTTPS(t *testing.T) {
+	if _, err := New(&config, WithDomain("http://localhost:8080")); !errors.Is(err, lrerror.ErrInitialization) {
+		t.Errorf("Expected a plain HTTP domain to be rejected, got: %v", err)
+	}
+	if _, err := New(&config, WithEndpointResolver(Endpoints{ConfigAPI: "http://localhost:8080"})); !errors.Is(err, lrerror.ErrInitialization) {
+		t.Errorf("Expected a plain HTTP resolved endpoint to be rejected, got: %v", err)
+	}
+	if _, err := New(&config, WithAllowHTTP(), WithDomain("http://localhost:8080")); err != nil {
+		t.Errorf("Expected a plain HTTP domain to be allowed with WithAllowHTTP, got: %v", err)
+	}
+}
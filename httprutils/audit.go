@@ -0,0 +1,64 @@
+package httprutils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+// secretQueryParams are the names, compared case-insensitively, of the query parameters
+// LoginRadius accepts the API secret in. The SDK sends the secret in headers instead, since
+// URLs end up in proxy and access logs.
+var secretQueryParams = []string{"apisecret", "secret", "api_secret"}
+
+// SecretInURLOperations lists the endpoints, by their Endpoint.Operation, that LoginRadius
+// documents with the API secret in a query parameter only, and that the SDK therefore calls
+// with the secret in their URL. Their calls are not rejected by RejectSecretInURL, but are
+// still logged as a warning.
+var SecretInURLOperations = map[string]bool{
+	"webhook.PostWebhookSubscribe":            true,
+	"webhook.GetWebhookTest":                  true,
+	"webhook.GetWebhookSubscribedURLs":        true,
+	"webhook.DeleteWebhookUnsubscribe":        true,
+	"lrsocial.GetSocialAccessToken":           true,
+	"lrsocial.GetSocialTokenValidate":         true,
+	"lrsocial.GetSocialTokenInvalidate":       true,
+	"lrconfiguration.GetActiveSessionDetails": true,
+	"tokenmanagement.GetRefreshToken":         true,
+}
+
+// SecretInURL returns the name of the first query parameter of u holding an API secret,
+// or "" if there is none.
+func SecretInURL(u *url.URL) string {
+	for name := range u.Query() {
+		for _, secret := range secretQueryParams {
+			if strings.EqualFold(name, secret) {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// auditURL flags a request to endpoint carrying an API secret in its URL: it is rejected if
+// the Client has RejectSecretInURL set and endpoint is not listed in SecretInURLOperations,
+// and logged as a warning otherwise.
+func (c *Client) auditURL(ctx context.Context, req *http.Request, endpoint Endpoint) error {
+	name := SecretInURL(req.URL)
+	if name == "" {
+		return nil
+	}
+	if c.RejectSecretInURL && !SecretInURLOperations[endpoint.Operation] {
+		errMsg := "Request carries an API secret in its URL, in the " + name + " query parameter"
+		return lrerror.New(lrerror.CodeSecretInURL, errMsg, errors.New(errMsg))
+	}
+	if c.Logger != nil {
+		c.Logger.WarnContext(ctx, "LoginRadius API request carries an API secret in its URL",
+			"method", req.Method, "path", req.URL.Path, "param", name)
+	}
+	return nil
+}
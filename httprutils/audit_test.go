@@ -0,0 +1,46 @@
+package httprutils
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+func TestAuditSecretInURL(t *testing.T) {
+	stub, calls := flakyServer(0, http.StatusOK, nil)
+	defer stub.Close()
+	request := Request{Method: Get, URL: stub.URL + "/identity/v2/manage/account", QueryParams: map[string]string{"apikey": "abcd1234", "ApiSecret": "abcd1234"}}
+
+	var logs bytes.Buffer
+	client := &Client{HTTPClient: http.DefaultClient, Logger: slog.New(slog.NewTextHandler(&logs, nil))}
+	if _, err := client.Send(request); err != nil {
+		t.Fatalf("Expected the call to be sent, got: %v", err)
+	}
+	if !strings.Contains(logs.String(), "carries an API secret in its URL") {
+		t.Errorf("Expected the secret in the URL to be flagged, got %s", logs.String())
+	}
+
+	client.RejectSecretInURL = true
+	if _, err := client.Send(request); !errors.Is(err, lrerror.ErrSecretInURL) || *calls != 1 {
+		t.Errorf("Expected the call to be rejected before being sent, got %v after %d calls", err, *calls)
+	}
+
+	request.URL = stub.URL + "/api/v2/webhook"
+	if _, err := client.Send(request); !errors.Is(err, lrerror.ErrSecretInURL) || *calls != 1 {
+		t.Errorf("Expected calls to the legacy APIs not listed to be rejected, got %v after %d calls", err, *calls)
+	}
+
+	logs.Reset()
+	request.Endpoint = Endpoint{Operation: "webhook.GetWebhookSubscribedURLs", PathTemplate: "/api/v2/webhook"}
+	if _, err := client.Send(request); err != nil || *calls != 2 {
+		t.Errorf("Expected the listed endpoints to be called with the secret in their URL, got %v after %d calls", err, *calls)
+	}
+	if !strings.Contains(logs.String(), "carries an API secret in its URL") {
+		t.Errorf("Expected the listed endpoints to still be flagged, got %s", logs.String())
+	}
+}
@@ -0,0 +1,289 @@
+package httprutils
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of a circuit of a CircuitBreaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets calls through, counting their consecutive failures.
+	CircuitClosed CircuitState = iota
+
+	// CircuitOpen fails calls without making them until its cool-down has passed.
+	CircuitOpen
+
+	// CircuitHalfOpen lets one trial call through at a time, closing the circuit once
+	// enough of them succeed and opening it again on their first failure.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("CircuitState(%d)", int(s))
+}
+
+// Defaults of the CircuitBreaker settings left at zero.
+const (
+	DefaultFailureThreshold = 5
+	DefaultCoolDown         = 30 * time.Second
+)
+
+// CircuitBreaker makes calls fail fast while LoginRadius is failing, rather than having
+// every call wait for the timeout of the http.Client.
+//
+// Calls are grouped in circuits, one per host by default. A circuit opens after
+// FailureThreshold consecutive failed calls, and then fails calls with a CircuitOpenError
+// without making them. After CoolDown, it turns half-open and lets a single trial call
+// through at a time: SuccessThreshold successful trial calls close it, and a failed one
+// opens it again. The outcome of calls let through before the circuit opened is ignored
+// once it is open or half-open.
+//
+// A CircuitBreaker must not be copied after first use, and may be shared by several Clients.
+type CircuitBreaker struct {
+	// FailureThreshold is the number of consecutive failed calls opening a circuit,
+	// DefaultFailureThreshold when zero.
+	FailureThreshold int
+
+	// SuccessThreshold is the number of successful trial calls closing a half-open
+	// circuit, 1 when zero.
+	SuccessThreshold int
+
+	// CoolDown is how long a circuit stays open before letting a trial call through,
+	// DefaultCoolDown when zero.
+	CoolDown time.Duration
+
+	// Scope returns the circuit of a request, its host by default. Use CircuitPerEndpointFamily
+	// to break circuits per endpoint family.
+	Scope func(req *http.Request) string
+
+	// IsFailure reports whether a call failed. By default, calls fail on transport errors,
+	// other than the cancellation of their context, and on 5xx responses.
+	IsFailure func(res *http.Response, err error) bool
+
+	// OnStateChange, when set, is called when a circuit changes state, e.g. to alert
+	// when LoginRadius becomes unavailable. It is called synchronously, after the change.
+	OnStateChange func(circuit string, from, to CircuitState)
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+	now      func() time.Time
+}
+
+// CircuitOpenError is the original error of the lrerror.Error returned for calls failed by
+// an open circuit, with code lrerror.CodeCircuitOpen. Access it with errors.As.
+type CircuitOpenError struct {
+	// Circuit is the circuit of the call.
+	Circuit string
+
+	// RetryIn is how long until the circuit lets a trial call through, zero while a
+	// trial call of a half-open circuit is in flight.
+	RetryIn time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit %s is open, calls are failing fast for %s", e.Circuit, e.RetryIn)
+}
+
+// CircuitPerEndpointFamily is a CircuitBreaker Scope breaking circuits per host and
+// endpoint family, see EndpointFamily.
+func CircuitPerEndpointFamily(req *http.Request) string {
+	return req.URL.Host + " " + EndpointFamily(req.URL.Path)
+}
+
+// Validate reports whether the circuit breaker is usable, returning an error describing
+// the first invalid setting otherwise.
+func (b *CircuitBreaker) Validate() error {
+	switch {
+	case b.FailureThreshold < 0:
+		return fmt.Errorf("circuit breaker FailureThreshold must not be negative, got %d", b.FailureThreshold)
+	case b.SuccessThreshold < 0:
+		return fmt.Errorf("circuit breaker SuccessThreshold must not be negative, got %d", b.SuccessThreshold)
+	case b.CoolDown < 0:
+		return fmt.Errorf("circuit breaker CoolDown must not be negative, got %s", b.CoolDown)
+	}
+	return nil
+}
+
+// State returns the state of the given circuit. Circuits that have not been used are closed.
+func (b *CircuitBreaker) State(circuit string) CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c, ok := b.circuits[circuit]
+	if !ok {
+		return CircuitClosed
+	}
+	if c.state == CircuitOpen && !b.clock().Before(c.openUntil) {
+		return CircuitHalfOpen
+	}
+	return c.state
+}
+
+// wrap returns next guarded by the CircuitBreaker.
+func (b *CircuitBreaker) wrap(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		name := b.scope(req)
+		trial, err := b.allow(name)
+		if err != nil {
+			return nil, err
+		}
+		res, err := next(req)
+		b.record(name, trial, req, res, err)
+		return res, err
+	}
+}
+
+func (b *CircuitBreaker) scope(req *http.Request) string {
+	if b.Scope != nil {
+		return b.Scope(req)
+	}
+	return req.URL.Host
+}
+
+// allow reports whether a call may be made on the given circuit, and whether it is the
+// trial call of a half-open circuit.
+func (b *CircuitBreaker) allow(name string) (bool, error) {
+	b.mu.Lock()
+	now := b.clock()
+	c := b.circuit(name)
+	var changed bool
+	if c.state == CircuitOpen && !now.Before(c.openUntil) {
+		c.state, c.successes, changed = CircuitHalfOpen, 0, true
+	}
+	var trial bool
+	var err error
+	switch {
+	case c.state == CircuitOpen:
+		err = &CircuitOpenError{Circuit: name, RetryIn: c.openUntil.Sub(now)}
+	case c.state == CircuitHalfOpen && c.trial:
+		err = &CircuitOpenError{Circuit: name}
+	case c.state == CircuitHalfOpen:
+		c.trial, trial = true, true
+	}
+	b.mu.Unlock()
+
+	if changed {
+		b.notify(name, CircuitOpen, CircuitHalfOpen)
+	}
+	return trial, err
+}
+
+// record updates the given circuit with the outcome of a call, trial reporting whether it
+// is the trial call of a half-open circuit.
+func (b *CircuitBreaker) record(name string, trial bool, req *http.Request, res *http.Response, err error) {
+	failed := b.failed(req, res, err)
+	b.mu.Lock()
+	c := b.circuit(name)
+	from := c.state
+	if trial {
+		c.trial = false
+	}
+	switch {
+	case failed == nil:
+		// The call neither succeeded nor failed, e.g. it was canceled.
+	case !trial && from != CircuitClosed:
+		// The call was let through before the circuit opened, only the trial call counts.
+	case *failed && from == CircuitHalfOpen, *failed && c.failures+1 >= b.failureThreshold():
+		c.state, c.failures, c.openUntil = CircuitOpen, 0, b.clock().Add(b.coolDown())
+	case *failed:
+		c.failures++
+	case from == CircuitHalfOpen:
+		c.successes++
+		if c.successes >= b.successThreshold() {
+			c.state, c.failures = CircuitClosed, 0
+		}
+	default:
+		c.failures = 0
+	}
+	to := c.state
+	b.mu.Unlock()
+
+	if from != to {
+		b.notify(name, from, to)
+	}
+}
+
+// failed reports whether a call failed, or nil if it is not counted either way.
+func (b *CircuitBreaker) failed(req *http.Request, res *http.Response, err error) *bool {
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) || (err != nil && req.Context().Err() != nil) {
+		return nil
+	}
+	var failed bool
+	if b.IsFailure != nil {
+		failed = b.IsFailure(res, err)
+	} else {
+		failed = err != nil || res.StatusCode >= http.StatusInternalServerError
+	}
+	return &failed
+}
+
+func (b *CircuitBreaker) notify(name string, from, to CircuitState) {
+	if b.OnStateChange != nil {
+		b.OnStateChange(name, from, to)
+	}
+}
+
+// circuit returns the given circuit, creating it closed. b.mu must be held.
+func (b *CircuitBreaker) circuit(name string) *circuit {
+	if b.circuits == nil {
+		b.circuits = make(map[string]*circuit)
+	}
+	c, ok := b.circuits[name]
+	if !ok {
+		c = &circuit{}
+		b.circuits[name] = c
+	}
+	return c
+}
+
+func (b *CircuitBreaker) failureThreshold() int {
+	if b.FailureThreshold > 0 {
+		return b.FailureThreshold
+	}
+	return DefaultFailureThreshold
+}
+
+func (b *CircuitBreaker) successThreshold() int {
+	if b.SuccessThreshold > 0 {
+		return b.SuccessThreshold
+	}
+	return 1
+}
+
+func (b *CircuitBreaker) coolDown() time.Duration {
+	if b.CoolDown > 0 {
+		return b.CoolDown
+	}
+	return DefaultCoolDown
+}
+
+func (b *CircuitBreaker) clock() time.Time {
+	if b.now != nil {
+		return b.now()
+	}
+	return time.Now()
+}
+
+// circuit is the state of a circuit of a CircuitBreaker.
+type circuit struct {
+	state     CircuitState
+	failures  int
+	successes int
+	openUntil time.Time
+
+	// trial is set while the trial call of a half-open circuit is in flight.
+	trial bool
+}
@@ -0,0 +1,133 @@
+package httprutils
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	var failing atomic.Bool
+	failing.Store(true)
+	var calls int32
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "{\"message\": \"success\"}")
+	}))
+	defer stub.Close()
+
+	now := time.Now()
+	var changes []string
+	breaker := &CircuitBreaker{
+		FailureThreshold: 2,
+		CoolDown:         time.Minute,
+		OnStateChange: func(circuit string, from, to CircuitState) {
+			changes = append(changes, fmt.Sprintf("%s->%s", from, to))
+		},
+		now: func() time.Time { return now },
+	}
+	client := &Client{HTTPClient: NetClient, CircuitBreaker: breaker, Retry: testRetryPolicy()}
+	circuit := stub.Listener.Addr().String()
+
+	// The retried call fails twice, opening the circuit before its third attempt.
+	_, err := client.Send(Request{Method: Get, URL: stub.URL})
+	var circuitErr *CircuitOpenError
+	if !errors.Is(err, lrerror.ErrCircuitOpen) || !errors.As(err, &circuitErr) {
+		t.Fatalf("Expected the circuit to open after 2 failures, got: %v", err)
+	}
+	if circuitErr.Circuit != circuit || circuitErr.RetryIn != time.Minute {
+		t.Errorf("Expected the circuit of the host to retry in a minute, got %+v", circuitErr)
+	}
+	if _, err := client.Send(Request{Method: Get, URL: stub.URL}); !errors.Is(err, lrerror.ErrCircuitOpen) {
+		t.Errorf("Expected the open circuit to fail calls fast, got: %v", err)
+	}
+	if atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("Expected the open circuit not to make calls, got %d calls", calls)
+	}
+
+	// After the cool-down, a failed trial call opens the circuit again.
+	now = now.Add(time.Minute)
+	if state := breaker.State(circuit); state != CircuitHalfOpen {
+		t.Errorf("Expected the circuit to be half-open after the cool-down, got %s", state)
+	}
+	client.Retry = nil
+	if _, err := client.Send(Request{Method: Get, URL: stub.URL}); errors.Is(err, lrerror.ErrCircuitOpen) {
+		t.Errorf("Expected the half-open circuit to let a trial call through, got: %v", err)
+	}
+	if state := breaker.State(circuit); state != CircuitOpen {
+		t.Errorf("Expected a failed trial call to open the circuit, got %s", state)
+	}
+
+	// A successful trial call closes it.
+	now = now.Add(time.Minute)
+	failing.Store(false)
+	if _, err := client.Send(Request{Method: Get, URL: stub.URL}); err != nil {
+		t.Fatalf("Expected the trial call to succeed, got: %v", err)
+	}
+	if state := breaker.State(circuit); state != CircuitClosed {
+		t.Errorf("Expected a successful trial call to close the circuit, got %s", state)
+	}
+
+	expected := "[closed->open open->half-open half-open->open open->half-open half-open->closed]"
+	if fmt.Sprint(changes) != expected {
+		t.Errorf("Expected state changes %s, got %v", expected, changes)
+	}
+}
+
+func TestCircuitBreakerCountsOnlyTrialCall(t *testing.T) {
+	now := time.Now()
+	breaker := &CircuitBreaker{FailureThreshold: 1, CoolDown: time.Minute, now: func() time.Time { return now }}
+	req, _ := http.NewRequest(http.MethodGet, "https://api.loginradius.com", nil)
+	ok := &http.Response{StatusCode: http.StatusOK}
+	failed := &http.Response{StatusCode: http.StatusServiceUnavailable}
+
+	// A slow call is let through before another call fails and opens the circuit.
+	slow, _ := breaker.allow("api")
+	breaker.allow("api")
+	breaker.record("api", false, req, failed, nil)
+
+	now = now.Add(time.Minute)
+	trial, err := breaker.allow("api")
+	if err != nil || !trial {
+		t.Fatalf("Expected the half-open circuit to let a trial call through, got: %v", err)
+	}
+	breaker.record("api", slow, req, ok, nil)
+	if state := breaker.State("api"); state != CircuitHalfOpen {
+		t.Errorf("Expected a late success of a call made before the circuit opened not to close it, got %s", state)
+	}
+	if _, err := breaker.allow("api"); err == nil {
+		t.Error("Expected the trial call to still be in flight")
+	}
+	breaker.record("api", trial, req, ok, nil)
+	if state := breaker.State("api"); state != CircuitClosed {
+		t.Errorf("Expected the successful trial call to close the circuit, got %s", state)
+	}
+}
+
+func TestCircuitBreakerScope(t *testing.T) {
+	req := func(path string) *http.Request {
+		r, _ := http.NewRequest(http.MethodGet, "https://api.loginradius.com"+path, nil)
+		return r
+	}
+	breaker := &CircuitBreaker{FailureThreshold: 1, Scope: CircuitPerEndpointFamily}
+	failing := breaker.wrap(func(*http.Request) (*http.Response, error) { return nil, errors.New("connection refused") })
+	failing(req("/identity/v2/manage/account/abc"))
+
+	if _, err := failing(req("/identity/v2/manage/account/def")); !errors.As(err, new(*CircuitOpenError)) {
+		t.Errorf("Expected the manage/account circuit to be open, got: %v", err)
+	}
+	if state := breaker.State("api.loginradius.com auth/login"); state != CircuitClosed {
+		t.Errorf("Expected the auth/login circuit to be closed, got %s", state)
+	}
+}
@@ -0,0 +1,379 @@
+package httprutils
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/lrcache"
+)
+
+// readCacheKeyPrefix prefixes the keys of the responses stored in the ReadCache backend.
+const readCacheKeyPrefix = "httprutils:read:"
+
+// Defaults of the ReadCache settings left at zero.
+const (
+	DefaultReadCacheTTL        = 5 * time.Second
+	DefaultReadCacheMaxEntries = 1000
+)
+
+// CacheableOperations lists the read-only endpoints a ReadCache caches the calls to by
+// default, by their Endpoint.Operation. Many LoginRadius GET endpoints have side effects,
+// such as sending emails and OTPs, verifying links or refreshing and revoking tokens, and
+// are left out so that every call to them reaches LoginRadius.
+var CacheableOperations = map[string]bool{
+	"lrauthentication.GetAuthReadProfilesByToken":          true,
+	"lrauthentication.GetAuthValidateAccessToken":          true,
+	"lrauthentication.GetAuthCheckEmailAvailability":       true,
+	"lrauthentication.GetAuthCheckUsernameAvailability":    true,
+	"lrauthentication.GetAuthSocialIdentity":               true,
+	"lraccount.GetManageAccountProfilesByUid":              true,
+	"lraccount.GetManageAccountProfilesByEmail":            true,
+	"lraccount.GetManageAccountProfilesByUsername":         true,
+	"lraccount.GetManageAccountProfilesByPhoneID":          true,
+	"lraccount.GetManageAccountIdentitiesByEmail":          true,
+	"phoneauthentication.GetPhoneNumberAvailability":       true,
+	"role.GetRolesList":                                    true,
+	"role.GetRolesByUID":                                   true,
+	"role.GetContextRolesPermissions":                      true,
+	"customobject.GetCustomObjectByToken":                  true,
+	"customobject.GetCustomObjectByUID":                    true,
+	"customobject.GetCustomObjectByObjectRecordIDAndToken": true,
+	"customobject.GetCustomObjectByObjectRecordIDAndUID":   true,
+	"lrconfiguration.GetConfiguration":                     true,
+	"webhook.GetWebhookSubscribedURLs":                     true,
+}
+
+// ReadCache caches the successful responses of GET calls for a short time, and collapses
+// concurrent identical GET calls into a single call whose response they all share. Only
+// the endpoints listed in CacheableOperations are cached, unless Cacheable is set. It
+// suits read endpoints called many times per second with the same access token, such
+// as GetAuthReadProfilesByToken or GetAuthValidateAccessToken.
+//
+// Calls are identical when they have the same method, URL, query parameters and
+// credentials. Cache keys are SHA-256 hashes, so that access tokens and API secrets
+// are never held in them.
+//
+// Since cached responses may be up to TTL old, invalidate them after changing what
+// they hold, e.g. with InvalidateToken after updating the profile of a user, or after
+// their access token is invalidated.
+//
+// Responses are kept in an in-memory lrcache.LRU, or in Backend when set, e.g. to share
+// them across the replicas of a service. Invalidation then only removes the responses
+// this ReadCache stored, those stored by other replicas expiring after TTL.
+//
+// A ReadCache must not be copied after first use, and may be shared by several Clients.
+type ReadCache struct {
+	// TTL is how long successful responses are cached, DefaultReadCacheTTL when zero.
+	TTL time.Duration
+
+	// MaxEntries bounds the number of cached responses, the least recently used ones
+	// being evicted first. DefaultReadCacheMaxEntries when zero.
+	MaxEntries int
+
+	// Cacheable, when set, reports whether a GET call to endpoint may be cached. Only the
+	// calls to the endpoints listed in CacheableOperations are cached otherwise.
+	Cacheable func(req *http.Request, endpoint Endpoint) bool
+
+	// Backend, when set, stores the cached responses. Its errors are treated as cache misses.
+	Backend lrcache.Cache
+
+	mu      sync.Mutex
+	backend lrcache.Cache
+	stored  map[string]*list.Element
+	lru     *list.List
+	flights map[string]*flight
+	now     func() time.Time
+}
+
+// storedKey is a key stored by the ReadCache, with the path and token hash it is
+// invalidated by.
+type storedKey struct {
+	key   string
+	path  string
+	token string
+}
+
+// cachedResponse is a response as stored in the backend.
+type cachedResponse struct {
+	StatusCode int
+	Headers    map[string][]string
+	Body       []byte
+	ExpiresAt  time.Time
+}
+
+// flight is a call in flight, shared by the identical calls made meanwhile.
+type flight struct {
+	done      chan struct{}
+	response  *Response
+	err       error
+	cancelled bool // the call failed because the context of its caller ended
+}
+
+// Validate reports whether the cache is usable, returning an error describing
+// the first invalid setting otherwise.
+func (c *ReadCache) Validate() error {
+	switch {
+	case c.TTL < 0:
+		return fmt.Errorf("read cache TTL must not be negative, got %s", c.TTL)
+	case c.MaxEntries < 0:
+		return fmt.Errorf("read cache MaxEntries must not be negative, got %d", c.MaxEntries)
+	}
+	return nil
+}
+
+// Len returns the number of responses stored by the ReadCache, including expired ones
+// not evicted yet.
+func (c *ReadCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.stored)
+}
+
+// Invalidate removes the cached responses to calls to the given URL path,
+// e.g. "/identity/v2/auth/account", whatever their query parameters and credentials.
+func (c *ReadCache) Invalidate(path string) {
+	c.remove(func(k *storedKey) bool { return k.path == path })
+}
+
+// InvalidatePrefix removes the cached responses to calls to URL paths starting with prefix,
+// e.g. "/identity/v2/manage/account/" after updating accounts.
+func (c *ReadCache) InvalidatePrefix(prefix string) {
+	c.remove(func(k *storedKey) bool { return strings.HasPrefix(k.path, prefix) })
+}
+
+// InvalidateToken removes the cached responses to calls made with the given access token,
+// sent in the Authorization header or the access_token query parameter.
+func (c *ReadCache) InvalidateToken(token string) {
+	hash := hashToken(token)
+	c.remove(func(k *storedKey) bool { return k.token == hash })
+}
+
+// Purge removes all cached responses.
+func (c *ReadCache) Purge() {
+	c.remove(func(*storedKey) bool { return true })
+}
+
+func (c *ReadCache) remove(match func(k *storedKey) bool) {
+	c.mu.Lock()
+	backend := c.getBackend()
+	var keys []string
+	for key, elem := range c.stored {
+		if match(elem.Value.(*storedKey)) {
+			c.lru.Remove(elem)
+			delete(c.stored, key)
+			keys = append(keys, key)
+		}
+	}
+	c.mu.Unlock()
+
+	for _, key := range keys {
+		backend.Delete(context.Background(), key)
+	}
+}
+
+// cacheable reports whether the response to req, a call to endpoint, may be cached.
+func (c *ReadCache) cacheable(req *http.Request, endpoint Endpoint) bool {
+	if req.Method != http.MethodGet {
+		return false
+	}
+	if c.Cacheable != nil {
+		return c.Cacheable(req, endpoint)
+	}
+	return CacheableOperations[endpoint.Operation]
+}
+
+// do returns the cached response to req, or calls call to get it, sharing the call with
+// the identical calls made meanwhile. It reports whether the response was cached.
+func (c *ReadCache) do(req *http.Request, call func() (*Response, int, error)) (*Response, int, bool, error) {
+	ctx := req.Context()
+	key, token := cacheKey(req)
+	if response, ok := c.lookup(ctx, key); ok {
+		return response, 0, true, nil
+	}
+
+	for {
+		c.mu.Lock()
+		f, ok := c.flights[key]
+		if !ok {
+			break
+		}
+		c.mu.Unlock()
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return nil, 0, false, ctx.Err()
+		}
+		switch {
+		case f.cancelled:
+			// The call failed because the context of its caller ended, which says nothing
+			// of this call: make it again.
+			continue
+		case f.err != nil:
+			return nil, 0, false, f.err
+		}
+		return f.response.clone(), 0, true, nil
+	}
+	f := &flight{done: make(chan struct{})}
+	if c.flights == nil {
+		c.flights = make(map[string]*flight)
+	}
+	c.flights[key] = f
+	c.mu.Unlock()
+
+	response, attempts, err := call()
+	f.response, f.err = response, err
+	if err != nil {
+		f.cancelled = ctx.Err() != nil
+	} else {
+		f.response = response.clone()
+		c.store(ctx, &storedKey{key: key, path: req.URL.Path, token: token}, f.response)
+	}
+
+	c.mu.Lock()
+	delete(c.flights, key)
+	c.mu.Unlock()
+	close(f.done)
+	return response, attempts, false, err
+}
+
+// lookup returns the response cached under key.
+func (c *ReadCache) lookup(ctx context.Context, key string) (*Response, bool) {
+	c.mu.Lock()
+	backend := c.getBackend()
+	c.mu.Unlock()
+
+	value, ok, err := backend.Get(ctx, key)
+	if err != nil || !ok {
+		return nil, false
+	}
+	var cached cachedResponse
+	if err := json.Unmarshal(value, &cached); err != nil || !c.clock().Before(cached.ExpiresAt) {
+		backend.Delete(ctx, key)
+		return nil, false
+	}
+
+	c.mu.Lock()
+	if elem, ok := c.stored[key]; ok {
+		c.lru.MoveToFront(elem)
+	}
+	c.mu.Unlock()
+	return &Response{
+		StatusCode: cached.StatusCode,
+		Body:       string(cached.Body),
+		Headers:    cached.Headers,
+		OrigBody:   cached.Body,
+	}, true
+}
+
+// store caches response under stored.key, forgetting the least recently used keys beyond MaxEntries.
+func (c *ReadCache) store(ctx context.Context, stored *storedKey, response *Response) {
+	ttl := c.TTL
+	if ttl <= 0 {
+		ttl = DefaultReadCacheTTL
+	}
+	value, err := json.Marshal(cachedResponse{
+		StatusCode: response.StatusCode,
+		Headers:    response.Headers,
+		Body:       response.OrigBody,
+		ExpiresAt:  c.clock().Add(ttl),
+	})
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	backend := c.getBackend()
+	if c.stored == nil {
+		c.stored = make(map[string]*list.Element)
+		c.lru = list.New()
+	}
+	if elem, ok := c.stored[stored.key]; ok {
+		c.lru.Remove(elem)
+	}
+	c.stored[stored.key] = c.lru.PushFront(stored)
+	var evicted []string
+	for c.lru.Len() > c.maxEntries() {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.stored, oldest.Value.(*storedKey).key)
+		evicted = append(evicted, oldest.Value.(*storedKey).key)
+	}
+	c.mu.Unlock()
+
+	if backend.Set(ctx, stored.key, value, ttl) != nil {
+		c.remove(func(k *storedKey) bool { return k == stored })
+	}
+	for _, key := range evicted {
+		backend.Delete(ctx, key)
+	}
+}
+
+// getBackend returns the backend responses are stored in. c.mu must be held.
+func (c *ReadCache) getBackend() lrcache.Cache {
+	if c.backend == nil {
+		c.backend = c.Backend
+		if c.backend == nil {
+			c.backend = lrcache.NewLRU(c.maxEntries())
+		}
+	}
+	return c.backend
+}
+
+func (c *ReadCache) maxEntries() int {
+	if c.MaxEntries > 0 {
+		return c.MaxEntries
+	}
+	return DefaultReadCacheMaxEntries
+}
+
+func (c *ReadCache) clock() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+	return time.Now()
+}
+
+// cacheKey returns the cache key of req, hashing its method, URL and credentials,
+// and the hash of its access token if it has one.
+func cacheKey(req *http.Request) (string, string) {
+	token := req.URL.Query().Get("access_token")
+	if scheme, credentials, ok := strings.Cut(req.Header.Get("Authorization"), " "); ok && strings.EqualFold(scheme, "Bearer") {
+		token = credentials
+	}
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%s\n", req.Method, req.URL.String())
+	for _, header := range []string{"Authorization", "X-LoginRadius-ApiKey", "X-LoginRadius-ApiSecret"} {
+		fmt.Fprintf(hash, "%s\n", req.Header.Get(header))
+	}
+	key := readCacheKeyPrefix + hex.EncodeToString(hash.Sum(nil))
+	if token == "" {
+		return key, ""
+	}
+	return key, hashToken(token)
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// clone returns a copy of the response, so that callers sharing a cached response
+// cannot modify it.
+func (r *Response) clone() *Response {
+	clone := *r
+	clone.OrigBody = append([]byte(nil), r.OrigBody...)
+	clone.Headers = make(map[string][]string, len(r.Headers))
+	for k, v := range r.Headers {
+		clone.Headers[k] = append([]string(nil), v...)
+	}
+	return &clone
+}
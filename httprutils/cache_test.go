@@ -0,0 +1,204 @@
+package httprutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/lrcache"
+)
+
+func countingServer(delay time.Duration) (*httptest.Server, *int32) {
+	var calls int32
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		time.Sleep(delay)
+		fmt.Fprintf(w, "{\"call\": %d}", n)
+	}))
+	return stub, &calls
+}
+
+var readProfile = Endpoint{Operation: "lrauthentication.GetAuthReadProfilesByToken", PathTemplate: "/identity/v2/auth/account"}
+
+func tokenRequest(url, token string) Request {
+	return Request{Method: Get, URL: url, Headers: map[string]string{"Authorization": "Bearer " + token}, Endpoint: readProfile}
+}
+
+func cacheAll(*http.Request, Endpoint) bool { return true }
+
+func TestReadCache(t *testing.T) {
+	t.Parallel()
+	stub, calls := countingServer(0)
+	defer stub.Close()
+
+	now := time.Now()
+	cache := &ReadCache{TTL: time.Minute, now: func() time.Time { return now }}
+	client := &Client{HTTPClient: NetClient, ReadCache: cache}
+	url := stub.URL + "/identity/v2/auth/account"
+
+	first, _ := client.Send(tokenRequest(url, "token-a"))
+	second, _ := client.Send(tokenRequest(url, "token-a"))
+	if atomic.LoadInt32(calls) != 1 || second.Body != first.Body {
+		t.Errorf("Expected the second call to be served from the cache, got %d calls", *calls)
+	}
+	second.OrigBody[0] = 'x'
+	if third, _ := client.Send(tokenRequest(url, "token-a")); third.Body != first.Body || third.OrigBody[0] != '{' {
+		t.Error("Expected cached responses not to be shared with callers")
+	}
+
+	client.Send(tokenRequest(url, "token-b"))
+	if atomic.LoadInt32(calls) != 2 {
+		t.Errorf("Expected calls with another token not to be served from the cache, got %d calls", *calls)
+	}
+	client.Send(Request{Method: Post, URL: url, Endpoint: readProfile})
+	client.Send(Request{Method: Post, URL: url, Endpoint: readProfile})
+	if atomic.LoadInt32(calls) != 4 {
+		t.Errorf("Expected POST calls not to be cached, got %d calls", *calls)
+	}
+
+	cache.InvalidateToken("token-a")
+	client.Send(tokenRequest(url, "token-a"))
+	client.Send(tokenRequest(url, "token-b"))
+	if atomic.LoadInt32(calls) != 5 {
+		t.Errorf("Expected only the responses of the invalidated token to be removed, got %d calls", *calls)
+	}
+
+	now = now.Add(time.Minute)
+	client.Send(tokenRequest(url, "token-b"))
+	if atomic.LoadInt32(calls) != 6 {
+		t.Errorf("Expected expired responses not to be served, got %d calls", *calls)
+	}
+
+	cache.Invalidate("/identity/v2/auth/account")
+	if cache.Len() != 0 {
+		t.Errorf("Expected the responses to the path to be invalidated, got %d", cache.Len())
+	}
+}
+
+func TestReadCacheSharedBackend(t *testing.T) {
+	t.Parallel()
+	stub, calls := countingServer(0)
+	defer stub.Close()
+
+	backend := lrcache.NewLRU(10)
+	first := &Client{HTTPClient: NetClient, ReadCache: &ReadCache{Backend: backend}}
+	second := &Client{HTTPClient: NetClient, ReadCache: &ReadCache{Backend: backend}}
+	first.Send(tokenRequest(stub.URL+"/identity/v2/manage/role", "token"))
+	res, err := second.Send(tokenRequest(stub.URL+"/identity/v2/manage/role", "token"))
+	if err != nil || res.Body != `{"call": 1}` || atomic.LoadInt32(calls) != 1 {
+		t.Errorf("Expected the response to be shared through the backend, got %v and %d calls", err, *calls)
+	}
+
+	first.ReadCache.Invalidate("/identity/v2/manage/role")
+	second.Send(tokenRequest(stub.URL+"/identity/v2/manage/role", "token"))
+	if atomic.LoadInt32(calls) != 2 || backend.Len() != 1 {
+		t.Errorf("Expected the invalidated response to be removed from the backend, got %d calls", *calls)
+	}
+}
+
+func TestReadCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+	stub, calls := countingServer(0)
+	defer stub.Close()
+
+	client := &Client{HTTPClient: NetClient, ReadCache: &ReadCache{MaxEntries: 2, Cacheable: cacheAll}}
+	client.Send(Request{Method: Get, URL: stub.URL + "/a"})
+	client.Send(Request{Method: Get, URL: stub.URL + "/b"})
+	client.Send(Request{Method: Get, URL: stub.URL + "/a"})
+	client.Send(Request{Method: Get, URL: stub.URL + "/c"})
+	client.Send(Request{Method: Get, URL: stub.URL + "/a"})
+	if atomic.LoadInt32(calls) != 3 || client.ReadCache.Len() != 2 {
+		t.Errorf("Expected /b to be evicted, got %d calls and %d entries", *calls, client.ReadCache.Len())
+	}
+	client.Send(Request{Method: Get, URL: stub.URL + "/b"})
+	if atomic.LoadInt32(calls) != 4 {
+		t.Errorf("Expected /b to be requested again, got %d calls", *calls)
+	}
+}
+
+func TestReadCacheCollapsesConcurrentCalls(t *testing.T) {
+	t.Parallel()
+	stub, calls := countingServer(50 * time.Millisecond)
+	defer stub.Close()
+
+	client := &Client{HTTPClient: NetClient, ReadCache: &ReadCache{}}
+	var wg sync.WaitGroup
+	bodies := make([]string, 10)
+	for i := range bodies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := client.Send(tokenRequest(stub.URL, "token"))
+			if err != nil {
+				t.Errorf("Expected the call to succeed, got: %v", err)
+				return
+			}
+			bodies[i] = res.Body
+		}()
+	}
+	wg.Wait()
+	if atomic.LoadInt32(calls) != 1 {
+		t.Errorf("Expected concurrent identical calls to be collapsed, got %d calls", *calls)
+	}
+	for _, body := range bodies {
+		if body != bodies[0] {
+			t.Errorf("Expected all callers to get the same response, got %q and %q", body, bodies[0])
+		}
+	}
+}
+
+func TestReadCacheSkipsSideEffects(t *testing.T) {
+	t.Parallel()
+	stub, calls := countingServer(0)
+	defer stub.Close()
+
+	client := &Client{HTTPClient: NetClient, ReadCache: &ReadCache{}}
+	sendOTP := Request{Method: Get, URL: stub.URL + "/identity/v2/auth/login/passwordlesslogin/otp?phone=1234",
+		Endpoint: Endpoint{Operation: "phoneauthentication.GetPhoneSendOTP", PathTemplate: "/identity/v2/auth/login/passwordlesslogin/otp"}}
+	client.Send(sendOTP)
+	client.Send(sendOTP)
+	client.Send(Request{Method: Get, URL: stub.URL + "/unknown"})
+	client.Send(Request{Method: Get, URL: stub.URL + "/unknown"})
+	if atomic.LoadInt32(calls) != 4 || client.ReadCache.Len() != 0 {
+		t.Errorf("Expected endpoints not known to be read-only not to be cached, got %d calls", *calls)
+	}
+
+	client.ReadCache.Cacheable = cacheAll
+	client.Send(sendOTP)
+	client.Send(sendOTP)
+	if atomic.LoadInt32(calls) != 5 {
+		t.Errorf("Expected Cacheable to override the default endpoints, got %d calls", *calls)
+	}
+}
+
+func TestReadCacheRetriesCancelledCalls(t *testing.T) {
+	t.Parallel()
+	stub, calls := countingServer(100 * time.Millisecond)
+	defer stub.Close()
+
+	client := &Client{HTTPClient: NetClient, ReadCache: &ReadCache{}}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	leader := make(chan error)
+	go func() {
+		_, err := client.SendWithContext(ctx, tokenRequest(stub.URL, "token"))
+		leader <- err
+	}()
+	time.Sleep(5 * time.Millisecond)
+	res, err := client.Send(tokenRequest(stub.URL, "token"))
+	if err != nil || res.Body == "" {
+		t.Errorf("Expected the call sharing a cancelled call to be made again, got: %v", err)
+	}
+	if err := <-leader; !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the cancelled call to fail with its context error, got: %v", err)
+	}
+	if atomic.LoadInt32(calls) != 2 {
+		t.Errorf("Expected 2 calls, got %d", *calls)
+	}
+}
@@ -3,10 +3,12 @@ package httprutils
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"time"
@@ -30,6 +32,30 @@ type Request struct {
 	Headers     map[string]string
 	QueryParams map[string]string
 	Body        *bytes.Buffer
+
+	// Endpoint identifies the API called, for tracing, metrics, retries and read caching.
+	Endpoint Endpoint
+
+	// Signer, when set, signs the request once its URL and headers are final.
+	Signer Signer
+}
+
+// A Signer signs requests, e.g. to authenticate them without sending a credential.
+// Sign is called with the request about to be sent and its body, and sets its
+// signature headers.
+type Signer interface {
+	Sign(req *http.Request, body []byte) error
+}
+
+// Endpoint identifies the logical API endpoint of a request. Unlike the request URL,
+// it holds no user data, so it is suitable for naming spans and labelling metrics.
+type Endpoint struct {
+	// Operation is the SDK method making the call, e.g. "lraccount.GetManageAccountProfilesByUid".
+	Operation string
+
+	// PathTemplate is the request path with its parameters as placeholders,
+	// e.g. "/identity/v2/manage/account/{uid}".
+	PathTemplate string
 }
 
 // DefaultClient is used if no custom HTTP client is defined
@@ -44,6 +70,41 @@ var DefaultClient = &Client{HTTPClient: http.DefaultClient}
 // See https://golang.org/pkg/net/http
 type Client struct {
 	HTTPClient *http.Client
+
+	// Retry configures retries of failed calls, see RetryPolicy.
+	// Retries are disabled when Retry is nil.
+	Retry *RetryPolicy
+
+	// UserAgent, when set, is sent in the User-Agent header of requests not setting their own.
+	UserAgent string
+
+	// Logger, when set, receives the failed and retried calls, and at debug level every
+	// request and response. Secrets are redacted from the logs, see RedactURL,
+	// RedactHeaders and RedactBody.
+	Logger *slog.Logger
+
+	// Middleware wraps every attempt of every call, in order, see Middleware.
+	Middleware []Middleware
+
+	// Tracer, when set, traces every call in a span, see Tracer.
+	Tracer Tracer
+
+	// Metrics, when set, records every call, see Metrics.
+	Metrics Metrics
+
+	// RejectSecretInURL makes calls carrying an API secret in their URL fail with
+	// lrerror.ErrSecretInURL, but for the endpoints listed in SecretInURLOperations.
+	// Such calls are otherwise logged as a warning.
+	RejectSecretInURL bool
+
+	// RateLimiter, when set, limits the rate of calls, see RateLimiter.
+	RateLimiter *RateLimiter
+
+	// CircuitBreaker, when set, fails calls fast while LoginRadius is failing, see CircuitBreaker.
+	CircuitBreaker *CircuitBreaker
+
+	// ReadCache, when set, caches the responses of GET calls, see ReadCache.
+	ReadCache *ReadCache
 }
 
 // Response holds the response from an API call.
@@ -56,6 +117,12 @@ type Response struct {
 
 // BuildRequestObject creates the HTTP request object.
 func BuildRequestObject(request Request) (*http.Request, error) {
+	return BuildRequestObjectWithContext(context.Background(), request)
+}
+
+// BuildRequestObjectWithContext creates the HTTP request object bound to ctx, so that
+// cancelling ctx or reaching its deadline aborts the request in flight.
+func BuildRequestObjectWithContext(ctx context.Context, request Request) (*http.Request, error) {
 	// Add any query parameters to the URL.
 	if len(request.QueryParams) != 0 {
 		request.URL = AddQueryParams(request.URL, request.QueryParams)
@@ -66,9 +133,9 @@ func BuildRequestObject(request Request) (*http.Request, error) {
 		request.Body = encodedBody
 	}
 
-	req, err := http.NewRequest(string(request.Method), request.URL, request.Body)
+	req, err := http.NewRequestWithContext(ctx, string(request.Method), request.URL, request.Body)
 	if err != nil {
-		err = lrerror.New("EncodingError", "Error constructing http request", err)
+		err = lrerror.New(lrerror.CodeEncoding, "Error constructing http request", err)
 		return req, err
 	}
 	for key, value := range request.Headers {
@@ -86,7 +153,7 @@ func MakeRequest(req *http.Request) (*http.Response, error) {
 // BuildResponse builds the response struct.
 func BuildResponse(res *http.Response) (response *Response, err error) {
 	if res == nil || res.Body == nil {
-		err := lrerror.New("EncodingError", "Error reading the response body", errors.New("nil http response body"))
+		err := lrerror.New(lrerror.CodeEncoding, "Error reading the response body", errors.New("nil http response body"))
 		return nil, err
 	}
 
@@ -96,7 +163,7 @@ func BuildResponse(res *http.Response) (response *Response, err error) {
 			if !ok {
 				recoveredErr = fmt.Errorf("%v", r)
 			}
-			err = lrerror.New("EncodingError", "Error reading the response body", recoveredErr)
+			err = lrerror.New(lrerror.CodeEncoding, "Error reading the response body", recoveredErr)
 			response = nil
 		}
 	}()
@@ -104,13 +171,13 @@ func BuildResponse(res *http.Response) (response *Response, err error) {
 
 	body, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		err := lrerror.New("EncodingError", "Error reading the response body", err)
+		err := lrerror.New(lrerror.CodeEncoding, "Error reading the response body", err)
 		return nil, err
 	}
 
 	response = &Response{}
 	if res.StatusCode < 200 || res.StatusCode > 299 {
-		err = lrerror.New("LoginradiusRespondedWithError", "Received error response from Loginradius", errors.New(string(body)))
+		err = lrerror.NewAPIError(res.StatusCode, res.Header, body)
 	} else {
 		response = &Response{
 			StatusCode: res.StatusCode,
@@ -127,6 +194,11 @@ func Send(request Request) (*Response, error) {
 	return DefaultClient.Send(request)
 }
 
+// SendWithContext sends the request with DefaultClient, bound to ctx.
+func SendWithContext(ctx context.Context, request Request) (*Response, error) {
+	return DefaultClient.SendWithContext(ctx, request)
+}
+
 // The following functions enable the ability to define a
 // custom HTTP Client
 
@@ -137,21 +209,118 @@ func (c *Client) MakeRequest(req *http.Request) (*http.Response, error) {
 
 // Send will build your request, make the request, and build your response.
 func (c *Client) Send(request Request) (*Response, error) {
+	return c.SendWithContext(context.Background(), request)
+}
+
+// SendWithContext is the same as Send with the addition of the ability to pass a
+// context. The context is carried down to the transport, so cancellation and
+// deadlines abort the request in flight.
+func (c *Client) SendWithContext(ctx context.Context, request Request) (*Response, error) {
+	if c.Tracer == nil && c.Metrics == nil {
+		response, _, err := c.send(ctx, request)
+		return response, err
+	}
+
+	var span Span
+	if c.Tracer != nil {
+		ctx, span = c.Tracer.Start(ctx, request.Endpoint.name(request.Method))
+		defer span.End()
+	}
+	var bytesSent int
+	if request.Body != nil {
+		bytesSent = request.Body.Len()
+	}
+	if c.Metrics != nil {
+		c.Metrics.CallStarted(request.Endpoint.name(request.Method))
+	}
+	start := time.Now()
+
+	response, attempts, err := c.send(ctx, request)
+
+	if span != nil {
+		recordSpan(span, request, response, attempts, err)
+	}
+	if c.Metrics != nil {
+		c.Metrics.CallFinished(newCall(request, response, err, time.Since(start), attempts, bytesSent))
+	}
+	return response, err
+}
+
+// send builds the request, makes it and builds the response, returning the number
+// of attempts made.
+func (c *Client) send(ctx context.Context, request Request) (*Response, int, error) {
+	var body []byte
+	if request.Body != nil && (c.Logger != nil || request.Signer != nil) {
+		body = request.Body.Bytes()
+	}
+
 	// Build the HTTP request object.
-	req, err := BuildRequestObject(request)
+	req, err := BuildRequestObjectWithContext(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+	if err := c.auditURL(ctx, req, request.Endpoint); err != nil {
+		return nil, 0, err
+	}
+	if request.Signer != nil {
+		if err := request.Signer.Sign(req, body); err != nil {
+			return nil, 0, err
+		}
+	}
+	if c.UserAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent)
 	}
+	if c.Tracer != nil {
+		c.Tracer.Inject(ctx, req.Header)
+	}
+	if c.ReadCache != nil && c.ReadCache.cacheable(req, request.Endpoint) {
+		response, attempts, cached, err := c.ReadCache.do(req, func() (*Response, int, error) {
+			return c.exchange(ctx, req, body, request.Endpoint)
+		})
+		if cached && c.Logger != nil {
+			c.Logger.DebugContext(ctx, "LoginRadius API response served from the read cache", "method", req.Method, "path", req.URL.Path)
+		}
+		return response, attempts, err
+	}
+	return c.exchange(ctx, req, body, request.Endpoint)
+}
+
+// exchange makes the request to endpoint and builds the response, returning the number of
+// attempts made.
+func (c *Client) exchange(ctx context.Context, req *http.Request, body []byte, endpoint Endpoint) (*Response, int, error) {
+	c.logRequest(ctx, req, body)
+	start := time.Now()
 
-	// Build the HTTP client and make the request.
-	res, err := c.MakeRequest(req)
+	// Build the HTTP client and make the request, retrying it if the client has a RetryPolicy.
+	res, attempts, err := c.doWithRetry(req, endpoint)
 	if err != nil {
-		err := lrerror.New("MakeRequestError", "Error making the request", err)
-		return nil, err
+		if c.Logger != nil {
+			c.Logger.WarnContext(ctx, "LoginRadius API call failed", "method", req.Method, "path", req.URL.Path, "error", redactError(err))
+		}
+		var rateLimitErr *RateLimitError
+		if errors.As(err, &rateLimitErr) {
+			return nil, attempts, lrerror.New(lrerror.CodeRateLimited, "Rate limit exceeded", err)
+		}
+		var circuitErr *CircuitOpenError
+		if errors.As(err, &circuitErr) {
+			return nil, attempts, lrerror.New(lrerror.CodeCircuitOpen, "Circuit breaker is open", err)
+		}
+		err := lrerror.New(lrerror.CodeMakeRequest, "Error making the request", err)
+		return nil, attempts, err
 	}
 
 	// Build Response object.
-	return BuildResponse(res)
+	response, err := BuildResponse(res)
+	if c.Logger != nil {
+		var apiErr *lrerror.APIError
+		switch {
+		case errors.As(err, &apiErr):
+			c.logResponse(ctx, req, apiErr.StatusCode, apiErr.Headers, apiErr.Body, time.Since(start))
+		case err == nil:
+			c.logResponse(ctx, req, response.StatusCode, response.Headers, response.OrigBody, time.Since(start))
+		}
+	}
+	return response, attempts, err
 }
 
 var tr = &http.Transport{
@@ -177,14 +346,14 @@ func EncodeBody(body interface{}) (*bytes.Buffer, error) {
 		json.Unmarshal(asserted, &raw)
 		encodeErr := json.NewEncoder(buffer).Encode(&raw)
 		if encodeErr != nil {
-			err := lrerror.New("EncodingError", "Error encoding the request body", encodeErr)
+			err := lrerror.New(lrerror.CodeEncoding, "Error encoding the request body", encodeErr)
 			return nil, err
 		}
 	} else {
 
 		encodeErr := json.NewEncoder(buffer).Encode(body)
 		if encodeErr != nil {
-			err := lrerror.New("EncodingError", "Error encoding the request body", encodeErr)
+			err := lrerror.New(lrerror.CodeEncoding, "Error encoding the request body", encodeErr)
 			return nil, err
 		}
 	}
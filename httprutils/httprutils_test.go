@@ -2,6 +2,8 @@ package httprutils
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -9,6 +11,8 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/LoginRadius/go-sdk/lrerror"
 )
 
 func TestBuildURL(t *testing.T) {
@@ -239,7 +243,7 @@ func TestCustomHTTPClient(t *testing.T) {
 		Body:   body,
 	}
 
-	customClient := &Client{&http.Client{Timeout: time.Millisecond * 10}}
+	customClient := &Client{HTTPClient: &http.Client{Timeout: time.Millisecond * 10}}
 	_, err := customClient.Send(request)
 	if err == nil {
 		t.Error("A timeout did not trigger as expected")
@@ -248,3 +252,52 @@ func TestCustomHTTPClient(t *testing.T) {
 		t.Error("We did not receive the Timeout error")
 	}
 }
+
+func TestSendWithContextCancelled(t *testing.T) {
+	t.Parallel()
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Millisecond * 50)
+		fmt.Fprintln(w, "{\"message\": \"success\"}")
+	}))
+	defer stub.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+
+	request := Request{
+		Method: Get,
+		URL:    stub.URL + "/test_endpoint",
+	}
+	_, err := TimeoutClient.SendWithContext(ctx, request)
+	if err == nil {
+		t.Error("A context deadline did not abort the request as expected")
+	}
+	if !strings.Contains(err.Error(), "context deadline exceeded") {
+		t.Errorf("We did not receive the context deadline error, got: %v", err)
+	}
+}
+
+func TestBuildErrorResponse(t *testing.T) {
+	t.Parallel()
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "abcd")
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, "{\"Description\": \"The provided access token is invalid\", \"ErrorCode\": 906, \"Message\": \"Access token is invalid\", \"IsProviderError\": false}")
+	}))
+	defer stub.Close()
+
+	_, err := TimeoutClient.Send(Request{Method: Get, URL: stub.URL})
+	var apiErr *lrerror.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected an APIError, got: %v", err)
+	}
+	if apiErr.StatusCode != http.StatusUnauthorized || apiErr.ErrorCode != 906 || apiErr.Headers["X-Request-Id"][0] != "abcd" {
+		t.Errorf("APIError built incorrectly: %+v", apiErr)
+	}
+	if !errors.Is(err, lrerror.ErrInvalidToken) {
+		t.Error("Expected the APIError to match ErrInvalidToken")
+	}
+	if lrErr, ok := err.(lrerror.Error); !ok || lrErr.Code() != "LoginradiusRespondedWithError" || !strings.Contains(lrErr.OrigErr().Error(), "906") {
+		t.Errorf("Expected the APIError to satisfy lrerror.Error with the response body as OrigErr, got: %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+package httprutils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Redacted replaces secrets in logged requests and responses.
+const Redacted = "REDACTED"
+
+// maxLoggedBody is the number of bytes of a request or response body that are logged.
+const maxLoggedBody = 4096
+
+// sensitiveHeaders lists the canonical names of the headers whose value is never logged,
+// Digest holding the signature of signed calls.
+var sensitiveHeaders = map[string]bool{
+	"X-Loginradius-Apisecret": true,
+	"X-Loginradius-Sott":      true,
+	"Authorization":           true,
+	"Digest":                  true,
+	"Cookie":                  true,
+	"Set-Cookie":              true,
+}
+
+// sensitiveKeyParts are the substrings of query parameters and JSON keys, compared
+// case-insensitively, whose values are never logged: API secrets, passwords, OTPs,
+// SOTTs, the access, refresh and verification tokens, and the Google Authenticator seed
+// returned as a QR code and a manual entry code.
+var sensitiveKeyParts = []string{"secret", "password", "otp", "sott", "token", "securityanswer", "backupcode", "authenticatorcode", "qrcode", "manualentrycode"}
+
+// isSensitiveKey reports whether the value of a query parameter or JSON key must be redacted.
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactURL returns u as a string, with the values of sensitive query parameters redacted.
+func RedactURL(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.String()
+	}
+	query := u.Query()
+	for key := range query {
+		if isSensitiveKey(key) {
+			query[key] = []string{Redacted}
+		}
+	}
+	redacted := *u
+	redacted.RawQuery = query.Encode()
+	return redacted.String()
+}
+
+// RedactHeaders returns a copy of header, with the values of sensitive headers redacted.
+// The scheme of Authorization headers is kept, e.g. "Bearer REDACTED".
+func RedactHeaders(header http.Header) http.Header {
+	redacted := make(http.Header, len(header))
+	for key, values := range header {
+		if !sensitiveHeaders[http.CanonicalHeaderKey(key)] {
+			redacted[key] = values
+			continue
+		}
+		masked := make([]string, len(values))
+		for i, value := range values {
+			masked[i] = Redacted
+			if scheme, _, ok := strings.Cut(value, " "); ok && http.CanonicalHeaderKey(key) == "Authorization" {
+				masked[i] = scheme + " " + Redacted
+			}
+		}
+		redacted[key] = masked
+	}
+	return redacted
+}
+
+// RedactBody returns body with the values of sensitive JSON keys redacted, at any depth.
+// Bodies that are not JSON are replaced by their size, since they cannot be redacted.
+func RedactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return "[" + strconv.Itoa(len(body)) + " bytes]"
+	}
+	redacted, _ := json.Marshal(redactJSON(decoded))
+	if len(redacted) > maxLoggedBody {
+		return string(redacted[:maxLoggedBody]) + "...[truncated]"
+	}
+	return string(redacted)
+}
+
+func redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if isSensitiveKey(key) {
+				v[key] = Redacted
+			} else {
+				v[key] = redactJSON(nested)
+			}
+		}
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = redactJSON(nested)
+		}
+	}
+	return value
+}
+
+// redactError returns err with the URL of a transport error redacted, since it holds
+// the request's query parameters.
+func redactError(err error) string {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err.Error()
+	}
+	redacted := *urlErr
+	if parsed, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+		redacted.URL = RedactURL(parsed)
+	} else {
+		redacted.URL = Redacted
+	}
+	return redacted.Error()
+}
+
+// logRequest logs a request at debug level, if the Client's Logger enables it.
+func (c *Client) logRequest(ctx context.Context, req *http.Request, body []byte) {
+	if c.Logger == nil || !c.Logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	c.Logger.DebugContext(ctx, "LoginRadius API request",
+		"method", req.Method,
+		"url", RedactURL(req.URL),
+		"headers", RedactHeaders(req.Header),
+		"body", RedactBody(body))
+}
+
+// logResponse logs the response to a request at debug level, if the Client's Logger enables it.
+func (c *Client) logResponse(ctx context.Context, req *http.Request, status int, headers http.Header, body []byte, duration time.Duration) {
+	if c.Logger == nil || !c.Logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	c.Logger.DebugContext(ctx, "LoginRadius API response",
+		"method", req.Method,
+		"path", req.URL.Path,
+		"status", status,
+		"duration", duration,
+		"headers", RedactHeaders(headers),
+		"body", RedactBody(body))
+}
@@ -0,0 +1,94 @@
+package httprutils
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLoggingRedactsSecrets(t *testing.T) {
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"access_token": "response-access-token", "refresh_token": "response-refresh-token", "Profile": {"Uid": "test-uid"}}`)
+	}))
+	defer stub.Close()
+
+	var logs bytes.Buffer
+	client := &Client{
+		HTTPClient: http.DefaultClient,
+		Logger:     slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+	}
+	encoded, _ := EncodeBody(map[string]interface{}{
+		"email":    "test@example.com",
+		"password": "request-password",
+		"Security": map[string]string{"OldPassword": "request-old-password"},
+		"otp":      "request-otp",
+	})
+	_, err := client.Send(Request{
+		Method: Post,
+		URL:    stub.URL + "/identity/v2/auth/login",
+		Headers: map[string]string{
+			"X-LoginRadius-ApiSecret": "request-api-secret",
+			"X-LoginRadius-Sott":      "request-sott",
+			"Authorization":           "Bearer request-access-token",
+		},
+		QueryParams: map[string]string{"apikey": "public-api-key", "apisecret": "query-api-secret", "secret": "query-secret"},
+		Body:        encoded,
+	})
+	if err != nil {
+		t.Fatalf("Expected the call to succeed, got: %v", err)
+	}
+
+	logged := logs.String()
+	for _, secret := range []string{"request-api-secret", "request-sott", "request-access-token", "query-api-secret", "query-secret",
+		"request-password", "request-old-password", "request-otp", "response-access-token", "response-refresh-token"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("Expected %s to be redacted from the logs", secret)
+		}
+	}
+	for _, kept := range []string{"LoginRadius API request", "LoginRadius API response", "Bearer REDACTED", "public-api-key", "test@example.com", "test-uid"} {
+		if !strings.Contains(logged, kept) {
+			t.Errorf("Expected %s to be logged, got %s", kept, logged)
+		}
+	}
+}
+
+func TestLoggingRedactsTransportErrors(t *testing.T) {
+	var logs bytes.Buffer
+	client := &Client{HTTPClient: http.DefaultClient, Logger: slog.New(slog.NewTextHandler(&logs, nil))}
+	client.Send(Request{Method: Get, URL: "http://unreachable.invalid/api/v2/webhook", QueryParams: map[string]string{"apisecret": "query-api-secret"}})
+
+	if !strings.Contains(logs.String(), "LoginRadius API call failed") || strings.Contains(logs.String(), "query-api-secret") {
+		t.Errorf("Expected the failure to be logged without the secret, got %s", logs.String())
+	}
+}
+
+func TestRedactBodyNotJSON(t *testing.T) {
+	if redacted := RedactBody([]byte("password=abcd1234")); redacted != "[17 bytes]" {
+		t.Errorf("Expected a body that is not JSON to be replaced by its size, got %s", redacted)
+	}
+}
+
+func TestRedactHeadersSignature(t *testing.T) {
+	redacted := RedactHeaders(http.Header{"Digest": {"SHA-256=request-signature"}, "X-Request-Expires": {"2026-10-18 12:00:00"}})
+	if redacted.Get("Digest") != Redacted || redacted.Get("X-Request-Expires") == Redacted {
+		t.Errorf("Expected the signature to be redacted, got %v", redacted)
+	}
+}
+
+func TestRedactBodyQRCode(t *testing.T) {
+	redacted := RedactBody([]byte(`{"SecondFactorAuthentication": {"QRCode": "otpauth://totp/test?secret=TOTPSEED"}}`))
+	if strings.Contains(redacted, "TOTPSEED") {
+		t.Errorf("Expected the QR code to be redacted, got %s", redacted)
+	}
+}
+
+func TestRedactBodyManualEntryCode(t *testing.T) {
+	redacted := RedactBody([]byte(`{"SecondFactorAuthentication": {"ManualEntryCode": "TOTPSEED"}}`))
+	if strings.Contains(redacted, "TOTPSEED") {
+		t.Errorf("Expected the manual entry code to be redacted, got %s", redacted)
+	}
+}
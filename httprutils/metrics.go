@@ -0,0 +1,180 @@
+package httprutils
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+// Metrics records the API calls of a Client. Calls are identified by the name of their
+// Endpoint, the SDK method making them, rather than by their URL holding user data.
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	// CallStarted is called before a call to the named endpoint is made.
+	CallStarted(endpoint string)
+
+	// CallFinished is called once the call completed, successfully or not.
+	CallFinished(call Call)
+}
+
+// Call describes a completed API call.
+type Call struct {
+	// Endpoint is the name of the endpoint called, e.g. "lraccount.GetManageAccountProfilesByUid".
+	Endpoint string
+	Method   Method
+
+	// Duration is the time taken by the call, including retries.
+	Duration time.Duration
+	Attempts int
+
+	// StatusCode is the status of the last response, 0 if no response was received.
+	StatusCode int
+
+	// ErrorCode is the LoginRadius ErrorCode of the response, e.g. "1017", when the call
+	// failed with an lrerror.APIError carrying one. Otherwise it is the lrerror code of
+	// the call's error, e.g. "MakeRequestError", or "" if the call succeeded.
+	ErrorCode string
+
+	// BytesSent and BytesReceived are the sizes of the request and response bodies.
+	BytesSent     int
+	BytesReceived int
+}
+
+// StatusClass returns the class of the call's status code, e.g. "2xx",
+// or "none" if no response was received.
+func (c Call) StatusClass() string {
+	if c.StatusCode < 100 || c.StatusCode > 599 {
+		return "none"
+	}
+	return strconv.Itoa(c.StatusCode/100) + "xx"
+}
+
+// newCall returns the Call describing the outcome of a request.
+func newCall(request Request, response *Response, err error, duration time.Duration, attempts, bytesSent int) Call {
+	call := Call{
+		Endpoint:  request.Endpoint.name(request.Method),
+		Method:    request.Method,
+		Duration:  duration,
+		Attempts:  attempts,
+		BytesSent: bytesSent,
+	}
+	if response != nil {
+		call.StatusCode = response.StatusCode
+		call.BytesReceived = len(response.OrigBody)
+	}
+
+	var lrErr lrerror.Error
+	if errors.As(err, &lrErr) {
+		call.ErrorCode = lrErr.Code()
+	}
+	var apiErr *lrerror.APIError
+	if errors.As(err, &apiErr) {
+		call.StatusCode = apiErr.StatusCode
+		call.BytesReceived = len(apiErr.Body)
+		if apiErr.ErrorCode != 0 {
+			call.ErrorCode = strconv.Itoa(apiErr.ErrorCode)
+		}
+	}
+	return call
+}
+
+// A Registry creates labelled metrics, in the style of Prometheus client libraries.
+// See NewRegistryMetrics.
+type Registry interface {
+	Histogram(name, help string, labels []string) Observer
+	Counter(name, help string, labels []string) Observer
+	Gauge(name, help string, labels []string) Observer
+}
+
+// An Observer is a metric of a Registry. For a Histogram, Observe records a value,
+// for a Counter or a Gauge, it adds value, which is negative to decrease a Gauge.
+type Observer interface {
+	Observe(value float64, labelValues ...string)
+}
+
+// registryMetrics records calls in metrics created by a Registry.
+type registryMetrics struct {
+	duration      Observer
+	calls         Observer
+	bytesSent     Observer
+	bytesReceived Observer
+	inFlight      Observer
+}
+
+// NewRegistryMetrics returns Metrics recording calls in the following metrics of reg:
+//
+//	loginradius_request_duration_seconds  histogram  endpoint, status_class, error_code
+//	loginradius_requests_total            counter    endpoint, status_class, error_code
+//	loginradius_request_bytes_total       counter    endpoint
+//	loginradius_response_bytes_total      counter    endpoint
+//	loginradius_requests_in_flight        gauge      endpoint
+func NewRegistryMetrics(reg Registry) Metrics {
+	outcome := []string{"endpoint", "status_class", "error_code"}
+	endpoint := []string{"endpoint"}
+	return &registryMetrics{
+		duration:      reg.Histogram("loginradius_request_duration_seconds", "Duration of LoginRadius API calls, including retries.", outcome),
+		calls:         reg.Counter("loginradius_requests_total", "LoginRadius API calls.", outcome),
+		bytesSent:     reg.Counter("loginradius_request_bytes_total", "Bytes sent in LoginRadius API request bodies.", endpoint),
+		bytesReceived: reg.Counter("loginradius_response_bytes_total", "Bytes received in LoginRadius API response bodies.", endpoint),
+		inFlight:      reg.Gauge("loginradius_requests_in_flight", "LoginRadius API calls in flight.", endpoint),
+	}
+}
+
+func (m *registryMetrics) CallStarted(endpoint string) {
+	m.inFlight.Observe(1, endpoint)
+}
+
+func (m *registryMetrics) CallFinished(call Call) {
+	m.inFlight.Observe(-1, call.Endpoint)
+	m.duration.Observe(call.Duration.Seconds(), call.Endpoint, call.StatusClass(), call.ErrorCode)
+	m.calls.Observe(1, call.Endpoint, call.StatusClass(), call.ErrorCode)
+	m.bytesSent.Observe(float64(call.BytesSent), call.Endpoint)
+	m.bytesReceived.Observe(float64(call.BytesReceived), call.Endpoint)
+}
+
+// MemoryMetrics records calls in memory, e.g. to assert on them in tests.
+// The zero value is ready to use.
+type MemoryMetrics struct {
+	mu       sync.Mutex
+	calls    []Call
+	inFlight map[string]int
+}
+
+func (m *MemoryMetrics) CallStarted(endpoint string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.inFlight == nil {
+		m.inFlight = map[string]int{}
+	}
+	m.inFlight[endpoint]++
+}
+
+func (m *MemoryMetrics) CallFinished(call Call) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inFlight[call.Endpoint]--
+	m.calls = append(m.calls, call)
+}
+
+// Calls returns the completed calls to endpoint, or all completed calls if endpoint is "".
+func (m *MemoryMetrics) Calls(endpoint string) []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var calls []Call
+	for _, call := range m.calls {
+		if endpoint == "" || call.Endpoint == endpoint {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// InFlight returns the number of calls to endpoint in flight.
+func (m *MemoryMetrics) InFlight(endpoint string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.inFlight[endpoint]
+}
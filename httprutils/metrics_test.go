@@ -0,0 +1,99 @@
+package httprutils
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+func TestMemoryMetrics(t *testing.T) {
+	metrics := &MemoryMetrics{}
+	endpoint := Endpoint{Operation: "lraccount.GetManageAccountProfilesByUid", PathTemplate: "/identity/v2/manage/account/{uid}"}
+
+	var inFlight int
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		inFlight = metrics.InFlight(endpoint.Operation)
+		if strings.HasSuffix(r.URL.Path, "missing") {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"ErrorCode": 1017, "Message": "UID is not valid"}`)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "proxied") {
+			w.WriteHeader(http.StatusBadGateway)
+			fmt.Fprint(w, "Bad Gateway")
+			return
+		}
+		fmt.Fprint(w, `{"Uid": "test-uid"}`)
+	}))
+	defer stub.Close()
+
+	client := &Client{HTTPClient: http.DefaultClient, Metrics: metrics}
+	encoded, _ := EncodeBody(map[string]string{"FirstName": "Test"})
+	client.Send(Request{Method: Put, URL: stub.URL + "/identity/v2/manage/account/test-uid", Body: encoded, Endpoint: endpoint})
+	client.Send(Request{Method: Get, URL: stub.URL + "/identity/v2/manage/account/missing", Endpoint: endpoint})
+	client.Send(Request{Method: Get, URL: stub.URL + "/identity/v2/manage/account/proxied", Endpoint: endpoint})
+
+	if inFlight != 1 || metrics.InFlight(endpoint.Operation) != 0 {
+		t.Errorf("Expected the call to be in flight while made, got %d during and %d after", inFlight, metrics.InFlight(endpoint.Operation))
+	}
+	calls := metrics.Calls(endpoint.Operation)
+	if len(calls) != 3 {
+		t.Fatalf("Expected 3 calls labelled by endpoint, got %+v", metrics.Calls(""))
+	}
+	if ok := calls[0]; ok.StatusClass() != "2xx" || ok.ErrorCode != "" || ok.BytesSent != len(`{"FirstName":"Test"}`+"\n") ||
+		ok.BytesReceived != len(`{"Uid": "test-uid"}`) || ok.Duration <= 0 || ok.Attempts != 1 {
+		t.Errorf("Expected a successful call, got %+v", ok)
+	}
+	if failed := calls[1]; failed.StatusClass() != "4xx" || failed.ErrorCode != "1017" {
+		t.Errorf("Expected a failed call labelled by its LoginRadius ErrorCode, got %+v", failed)
+	}
+	if proxied := calls[2]; proxied.StatusClass() != "5xx" || proxied.ErrorCode != lrerror.CodeLoginradiusRespondedWithError {
+		t.Errorf("Expected a failed call without LoginRadius error to be labelled by its lrerror code, got %+v", proxied)
+	}
+
+	client.Send(Request{Method: Get, URL: "http://unreachable.invalid"})
+	if unreachable := metrics.Calls("GET"); len(unreachable) != 1 || unreachable[0].StatusClass() != "none" ||
+		unreachable[0].ErrorCode != lrerror.CodeMakeRequest {
+		t.Errorf("Expected a call without response, got %+v", unreachable)
+	}
+}
+
+type testObserver struct {
+	values map[string]float64
+}
+
+func (o *testObserver) Observe(value float64, labelValues ...string) {
+	o.values[strings.Join(labelValues, ",")] += value
+}
+
+type testRegistry map[string]*testObserver
+
+func (r testRegistry) observer(name string) Observer {
+	r[name] = &testObserver{values: map[string]float64{}}
+	return r[name]
+}
+
+func (r testRegistry) Histogram(name, help string, labels []string) Observer { return r.observer(name) }
+func (r testRegistry) Counter(name, help string, labels []string) Observer   { return r.observer(name) }
+func (r testRegistry) Gauge(name, help string, labels []string) Observer     { return r.observer(name) }
+
+func TestRegistryMetrics(t *testing.T) {
+	reg := testRegistry{}
+	metrics := NewRegistryMetrics(reg)
+
+	metrics.CallStarted("lrrole.GetRolesList")
+	if reg["loginradius_requests_in_flight"].values["lrrole.GetRolesList"] != 1 {
+		t.Error("Expected the call to be counted in flight")
+	}
+	metrics.CallFinished(Call{Endpoint: "lrrole.GetRolesList", StatusCode: 404, ErrorCode: "1017", BytesReceived: 42})
+
+	if reg["loginradius_requests_in_flight"].values["lrrole.GetRolesList"] != 0 ||
+		reg["loginradius_requests_total"].values["lrrole.GetRolesList,4xx,1017"] != 1 ||
+		reg["loginradius_response_bytes_total"].values["lrrole.GetRolesList"] != 42 {
+		t.Errorf("Expected the call to be recorded with its labels, got %+v", reg)
+	}
+}
@@ -0,0 +1,37 @@
+package httprutils
+
+import "net/http"
+
+// RoundTripFunc makes a single HTTP call, like http.RoundTripper.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a RoundTripFunc to add behavior to every call made by a Client,
+// such as setting headers, logging or collecting metrics:
+//
+//	addHeader := func(next httprutils.RoundTripFunc) httprutils.RoundTripFunc {
+//		return func(req *http.Request) (*http.Response, error) {
+//			req.Header.Set("X-Request-Id", newRequestID())
+//			return next(req)
+//		}
+//	}
+//
+// A Middleware may return a response or an error without calling next to short-circuit
+// the call. It must not modify the response body after reading it.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// roundTrip returns the Client's middleware chain wrapped around MakeRequest, limited by
+// the Client's RateLimiter and guarded by its CircuitBreaker. The first Middleware is the
+// outermost one, seeing requests first and responses last.
+func (c *Client) roundTrip() RoundTripFunc {
+	next := RoundTripFunc(c.MakeRequest)
+	if c.RateLimiter != nil {
+		next = c.RateLimiter.wrap(next)
+	}
+	if c.CircuitBreaker != nil {
+		next = c.CircuitBreaker.wrap(next)
+	}
+	for i := len(c.Middleware) - 1; i >= 0; i-- {
+		next = c.Middleware[i](next)
+	}
+	return next
+}
@@ -0,0 +1,60 @@
+package httprutils
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func recordingMiddleware(name string, calls *[]string) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			*calls = append(*calls, name)
+			return next(req)
+		}
+	}
+}
+
+func TestMiddlewareChain(t *testing.T) {
+	stub, _ := flakyServer(1, http.StatusServiceUnavailable, nil)
+	defer stub.Close()
+
+	var calls []string
+	client := &Client{
+		HTTPClient: http.DefaultClient,
+		Retry:      testRetryPolicy(),
+		Middleware: []Middleware{recordingMiddleware("outer", &calls), recordingMiddleware("inner", &calls)},
+	}
+	if _, err := client.Send(Request{Method: Get, URL: stub.URL}); err != nil {
+		t.Fatalf("Expected the call to succeed, got: %v", err)
+	}
+
+	// The chain runs in order, once per attempt.
+	if got := strings.Join(calls, ","); got != "outer,inner,outer,inner" {
+		t.Errorf("Expected the middleware to wrap every attempt in order, got %s", got)
+	}
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	blocked := errors.New("blocked")
+	client := &Client{
+		HTTPClient: http.DefaultClient,
+		Middleware: []Middleware{func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				if req.Method == string(Delete) {
+					return nil, blocked
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+			}
+		}},
+	}
+
+	if res, err := client.Send(Request{Method: Get, URL: "http://unreachable.invalid"}); err != nil || res.Body != "{}" {
+		t.Errorf("Expected the middleware response without reaching the network, got %v", err)
+	}
+	if _, err := client.Send(Request{Method: Delete, URL: "http://unreachable.invalid"}); !errors.Is(err, blocked) {
+		t.Errorf("Expected the middleware error to be returned, got: %v", err)
+	}
+}
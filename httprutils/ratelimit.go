@@ -0,0 +1,329 @@
+package httprutils
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit configures a token bucket allowing Rate calls per second on average,
+// with bursts of up to Burst calls. A zero Rate does not limit calls.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimiter limits the rate of API calls made by a Client, so that bulk jobs stay
+// within the LoginRadius rate limits rather than being answered with 429 responses.
+//
+// Every call takes a token from the Global bucket and, if its endpoint family is
+// listed in Families, from the bucket of its family:
+//
+//	limiter := &httprutils.RateLimiter{
+//		Global: httprutils.RateLimit{Rate: 50, Burst: 10},
+//		Families: map[string]httprutils.RateLimit{
+//			"manage/account": {Rate: 10, Burst: 5},
+//			"auth/login":     {Rate: 5, Burst: 5},
+//		},
+//	}
+//
+// Calls wait for their tokens, unless FailFast is set or the wait exceeds MaxWait, in
+// which case they fail with a RateLimitError. Retried calls take a token per attempt.
+//
+// The limiter adapts to the responses of LoginRadius: after a 429 response it pauses the
+// buckets of the call for the delay given by the Retry-After or X-RateLimit-Reset header,
+// and halves their rate, which then recovers gradually as calls succeed. Responses with
+// an X-RateLimit-Remaining header of 0 pause the buckets until X-RateLimit-Reset as well.
+//
+// A RateLimiter must not be copied after first use, and may be shared by several Clients
+// to limit their calls together.
+type RateLimiter struct {
+	// Global limits all calls.
+	Global RateLimit
+
+	// Families limits calls per endpoint family. A family is looked up as returned by
+	// Family, e.g. "manage/account", then by its first segment, e.g. "manage".
+	Families map[string]RateLimit
+
+	// Family returns the endpoint family of a request, EndpointFamily of its path by default.
+	Family func(req *http.Request) string
+
+	// FailFast makes calls fail rather than wait for a token.
+	FailFast bool
+
+	// MaxWait, when positive, makes calls fail rather than wait longer than MaxWait.
+	MaxWait time.Duration
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+// globalBucket is the key of the global bucket, which no endpoint family can have.
+const globalBucket = ""
+
+// defaultRateLimitPause is how long the buckets of a call answered with 429 are paused
+// when the response gives no delay.
+const defaultRateLimitPause = time.Second
+
+// RateLimitError is the original error of the lrerror.Error returned for calls refused by
+// a RateLimiter, with code lrerror.CodeRateLimited. Access it with errors.As.
+type RateLimitError struct {
+	// Family is the endpoint family of the call.
+	Family string
+
+	// Wait is how long the call would have had to wait for a token.
+	Wait time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit of %s calls exceeded, next call allowed in %s", e.Family, e.Wait)
+}
+
+// EndpointFamily returns the endpoint family of an API path: its two segments following
+// the API version, e.g. "manage/account" for "/identity/v2/manage/account/{uid}" and
+// "auth/login" for "/identity/v2/auth/login", or its first two segments for unversioned
+// paths, e.g. "ciam/appinfo".
+func EndpointFamily(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) > 2 && segments[1] == "v2" {
+		segments = segments[2:]
+	}
+	if len(segments) > 2 {
+		segments = segments[:2]
+	}
+	return strings.Join(segments, "/")
+}
+
+// Validate reports whether the limiter is usable, returning an error describing
+// the first invalid setting otherwise.
+func (l *RateLimiter) Validate() error {
+	if err := l.Global.validate("global"); err != nil {
+		return err
+	}
+	for family, limit := range l.Families {
+		if family == globalBucket {
+			return errors.New("rate limiter families must not be empty")
+		}
+		if err := limit.validate(family); err != nil {
+			return err
+		}
+	}
+	if l.MaxWait < 0 {
+		return fmt.Errorf("rate limiter MaxWait must not be negative, got %s", l.MaxWait)
+	}
+	return nil
+}
+
+func (r RateLimit) validate(name string) error {
+	switch {
+	case r.Rate < 0:
+		return fmt.Errorf("%s rate limit must not be negative, got %g", name, r.Rate)
+	case r.Rate > 0 && r.Burst < 1:
+		return fmt.Errorf("%s rate limit Burst must be at least 1, got %d", name, r.Burst)
+	}
+	return nil
+}
+
+// wrap returns next limited by the RateLimiter.
+func (l *RateLimiter) wrap(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		family := l.family(req)
+		if err := l.wait(req, family); err != nil {
+			return nil, err
+		}
+		res, err := next(req)
+		if err == nil {
+			l.observe(family, res)
+		}
+		return res, err
+	}
+}
+
+func (l *RateLimiter) family(req *http.Request) string {
+	if l.Family != nil {
+		return l.Family(req)
+	}
+	return EndpointFamily(req.URL.Path)
+}
+
+// wait takes a token for a call of the given family, waiting for it if needed.
+func (l *RateLimiter) wait(req *http.Request, family string) error {
+	l.mu.Lock()
+	now := l.clock()
+	buckets := l.bucketsOf(family)
+	var delay time.Duration
+	for _, b := range buckets {
+		if d := b.reserve(now); d > delay {
+			delay = d
+		}
+	}
+	if delay > 0 && (l.FailFast || (l.MaxWait > 0 && delay > l.MaxWait)) {
+		for _, b := range buckets {
+			b.cancel()
+		}
+		l.mu.Unlock()
+		return &RateLimitError{Family: family, Wait: delay}
+	}
+	l.mu.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-req.Context().Done():
+		l.mu.Lock()
+		for _, b := range buckets {
+			b.cancel()
+		}
+		l.mu.Unlock()
+		return req.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// observe adapts the buckets of a call of the given family to its response.
+func (l *RateLimiter) observe(family string, res *http.Response) {
+	pause, limited := rateLimitPause(res)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.clock()
+	for _, b := range l.bucketsOf(family) {
+		switch {
+		case res.StatusCode == http.StatusTooManyRequests:
+			b.slowDown(now, pause)
+		case limited:
+			b.pause(now, pause)
+		case res.StatusCode < http.StatusBadRequest:
+			b.speedUp()
+		}
+	}
+}
+
+// rateLimitPause returns how long to pause calls after res, and whether res reports the
+// rate limit as exhausted. 429 responses without a delay pause calls for a second.
+func rateLimitPause(res *http.Response) (time.Duration, bool) {
+	if after, ok := retryAfter(res); ok {
+		return after, res.StatusCode == http.StatusTooManyRequests
+	}
+	reset, hasReset := rateLimitReset(res)
+	exhausted := res.Header.Get("X-RateLimit-Remaining") == "0" && hasReset
+	if res.StatusCode != http.StatusTooManyRequests && !exhausted {
+		return 0, false
+	}
+	if !hasReset {
+		reset = defaultRateLimitPause
+	}
+	return reset, true
+}
+
+// rateLimitReset parses the X-RateLimit-Reset header of res, which holds either a number
+// of seconds or a Unix time. It returns false if the header is missing or malformed.
+func rateLimitReset(res *http.Response) (time.Duration, bool) {
+	seconds, err := strconv.ParseInt(res.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	// Values beyond a year are Unix times rather than delays.
+	if seconds > 365*24*60*60 {
+		delay := time.Until(time.Unix(seconds, 0))
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// bucketsOf returns the buckets a call of the given family takes tokens from. l.mu must be held.
+func (l *RateLimiter) bucketsOf(family string) []*bucket {
+	if l.buckets == nil {
+		l.buckets = make(map[string]*bucket)
+	}
+	buckets := []*bucket{l.bucket(globalBucket, l.Global)}
+	if limit, ok := l.Families[family]; ok {
+		buckets = append(buckets, l.bucket(family, limit))
+	} else if prefix, _, found := strings.Cut(family, "/"); found {
+		if limit, ok := l.Families[prefix]; ok {
+			buckets = append(buckets, l.bucket(prefix, limit))
+		}
+	}
+	return buckets
+}
+
+func (l *RateLimiter) bucket(name string, limit RateLimit) *bucket {
+	b, ok := l.buckets[name]
+	if !ok {
+		b = &bucket{limit: limit, rate: limit.Rate, tokens: float64(limit.Burst), last: l.clock()}
+		l.buckets[name] = b
+	}
+	return b
+}
+
+func (l *RateLimiter) clock() time.Time {
+	if l.now != nil {
+		return l.now()
+	}
+	return time.Now()
+}
+
+// bucket is a token bucket whose rate adapts to 429 responses. Its tokens go negative
+// as calls reserve tokens ahead of time.
+type bucket struct {
+	limit       RateLimit
+	rate        float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+// reserve takes a token and returns how long to wait before using it.
+func (b *bucket) reserve(now time.Time) time.Duration {
+	var delay time.Duration
+	if b.pausedUntil.After(now) {
+		delay = b.pausedUntil.Sub(now)
+	}
+	if b.limit.Rate <= 0 {
+		return delay
+	}
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = min(float64(b.limit.Burst), b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+	b.tokens--
+	if b.tokens < 0 {
+		delay = max(delay, time.Duration(-b.tokens/b.rate*float64(time.Second)))
+	}
+	return delay
+}
+
+// cancel returns a token reserved by a call that was not made.
+func (b *bucket) cancel() {
+	if b.limit.Rate > 0 {
+		b.tokens++
+	}
+}
+
+func (b *bucket) pause(now time.Time, pause time.Duration) {
+	if until := now.Add(pause); until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+}
+
+// slowDown pauses the bucket and halves its rate, down to a sixteenth of its limit.
+func (b *bucket) slowDown(now time.Time, pause time.Duration) {
+	b.pause(now, pause)
+	b.rate = max(b.rate/2, b.limit.Rate/16)
+}
+
+// speedUp recovers a twentieth of the limit of a slowed down bucket.
+func (b *bucket) speedUp() {
+	b.rate = min(b.limit.Rate, b.rate+b.limit.Rate/20)
+}
@@ -0,0 +1,125 @@
+package httprutils
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+func TestEndpointFamily(t *testing.T) {
+	cases := map[string]string{
+		"/identity/v2/manage/account/abc": "manage/account",
+		"/identity/v2/auth/login":         "auth/login",
+		"/api/v2/access_token":            "access_token",
+		"/ciam/appinfo":                   "ciam/appinfo",
+	}
+	for path, family := range cases {
+		if got := EndpointFamily(path); got != family {
+			t.Errorf("Expected %s to belong to the %s family, got %s", path, family, got)
+		}
+	}
+}
+
+func TestRateLimiterFailsFast(t *testing.T) {
+	t.Parallel()
+	stub, calls := flakyServer(0, http.StatusOK, nil)
+	defer stub.Close()
+
+	limiter := &RateLimiter{
+		Global:   RateLimit{Rate: 100, Burst: 10},
+		Families: map[string]RateLimit{"manage": {Rate: 1, Burst: 2}},
+		FailFast: true,
+	}
+	client := &Client{HTTPClient: NetClient, RateLimiter: limiter}
+	for i := 0; i < 2; i++ {
+		if _, err := client.Send(Request{Method: Get, URL: stub.URL + "/identity/v2/manage/account"}); err != nil {
+			t.Fatalf("Expected the calls within the burst to succeed, got: %v", err)
+		}
+	}
+
+	_, err := client.Send(Request{Method: Get, URL: stub.URL + "/identity/v2/manage/account"})
+	var rateLimitErr *RateLimitError
+	if !errors.Is(err, lrerror.ErrRateLimited) || !errors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected the call beyond the burst to fail with ErrRateLimited, got: %v", err)
+	}
+	if rateLimitErr.Family != "manage/account" || rateLimitErr.Wait <= 0 || rateLimitErr.Wait > time.Second {
+		t.Errorf("Expected the call to wait up to a second for a manage/account token, got %+v", rateLimitErr)
+	}
+	if _, err := client.Send(Request{Method: Get, URL: stub.URL + "/identity/v2/auth/login"}); err != nil {
+		t.Errorf("Expected calls of other families to be unaffected, got: %v", err)
+	}
+	if *calls != 3 {
+		t.Errorf("Expected 3 calls to be made, got %d", *calls)
+	}
+}
+
+func TestRateLimiterWaits(t *testing.T) {
+	t.Parallel()
+	stub, calls := flakyServer(0, http.StatusOK, nil)
+	defer stub.Close()
+
+	client := &Client{HTTPClient: NetClient, RateLimiter: &RateLimiter{Global: RateLimit{Rate: 20, Burst: 1}}}
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := client.Send(Request{Method: Get, URL: stub.URL}); err != nil {
+			t.Fatalf("Expected the limited calls to succeed, got: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected 3 calls at 20 per second to take 100ms, took %s", elapsed)
+	}
+	if *calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", *calls)
+	}
+}
+
+func TestRateLimiterAdaptsTo429(t *testing.T) {
+	t.Parallel()
+	stub, _ := flakyServer(1, http.StatusTooManyRequests, map[string]string{"Retry-After": "30"})
+	defer stub.Close()
+
+	now := time.Now()
+	limiter := &RateLimiter{Global: RateLimit{Rate: 10, Burst: 10}, FailFast: true, now: func() time.Time { return now }}
+	client := &Client{HTTPClient: NetClient, RateLimiter: limiter}
+	_, err := client.Send(Request{Method: Get, URL: stub.URL})
+	if !errors.Is(err, lrerror.ErrRateLimited) {
+		t.Errorf("Expected a 429 response to match ErrRateLimited, got: %v", err)
+	}
+
+	_, err = client.Send(Request{Method: Get, URL: stub.URL})
+	var rateLimitErr *RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.Wait != 30*time.Second {
+		t.Fatalf("Expected calls to be paused for the Retry-After delay, got: %v", err)
+	}
+	if rate := limiter.buckets[globalBucket].rate; rate != 5 {
+		t.Errorf("Expected the rate to be halved, got %g", rate)
+	}
+
+	now = now.Add(30 * time.Second)
+	if _, err := client.Send(Request{Method: Get, URL: stub.URL}); err != nil {
+		t.Fatalf("Expected calls to resume after the pause, got: %v", err)
+	}
+	if rate := limiter.buckets[globalBucket].rate; rate != 5.5 {
+		t.Errorf("Expected the rate to recover after a successful call, got %g", rate)
+	}
+}
+
+func TestRateLimiterPausesOnExhaustedLimit(t *testing.T) {
+	t.Parallel()
+	stub, _ := flakyServer(1, http.StatusOK, map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "2"})
+	defer stub.Close()
+
+	limiter := &RateLimiter{FailFast: true}
+	client := &Client{HTTPClient: NetClient, RateLimiter: limiter}
+	if _, err := client.Send(Request{Method: Get, URL: stub.URL}); err != nil {
+		t.Fatalf("Expected the first call to succeed, got: %v", err)
+	}
+	_, err := client.Send(Request{Method: Get, URL: stub.URL})
+	var rateLimitErr *RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.Wait <= time.Second {
+		t.Errorf("Expected calls to be paused until the rate limit resets, got: %v", err)
+	}
+}
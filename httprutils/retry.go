@@ -0,0 +1,256 @@
+package httprutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how Client retries failed API calls.
+// A nil policy on the Client disables retries, and every call is attempted exactly once.
+//
+// A call is retried when the transport fails (e.g. a connection reset) or when LoginRadius
+// responds with one of RetryStatusCodes, and only if the request method is listed in
+// RetryMethods. Requests that are not idempotent, such as POST, are not retried by default
+// since a retry after a lost response could apply the same change twice. For the same
+// reason, the endpoints listed in NonRetryableOperations are not retried unless Retryable
+// is set.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry. Each subsequent retry multiplies
+	// the previous delay by Multiplier, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+
+	// Jitter is the fraction, between 0 and 1, of each delay that is randomized,
+	// so that many clients failing at once do not retry in lockstep.
+	Jitter float64
+
+	// RetryStatusCodes lists the HTTP status codes that are retried.
+	RetryStatusCodes []int
+
+	// RetryMethods lists the HTTP methods that are safe to retry.
+	RetryMethods []Method
+
+	// Retryable, when set, reports whether a call to endpoint, made with one of RetryMethods,
+	// may be retried. The calls to the endpoints listed in NonRetryableOperations are not
+	// retried otherwise.
+	Retryable func(req *http.Request, endpoint Endpoint) bool
+}
+
+// NonRetryableOperations lists the endpoints, by their Endpoint.Operation, that are called
+// with GET, PUT or DELETE but send an email or SMS to the user, such as an OTP, a
+// passwordless login link or a welcome email. A call failing with a 5xx response may still
+// have sent it, so a RetryPolicy does not retry them by default, lest the user get it twice.
+var NonRetryableOperations = map[string]bool{
+	"lrauthentication.GetAuthSendWelcomeEmail":                  true,
+	"lrauthentication.GetPasswordlessLoginByEmail":              true,
+	"lrauthentication.GetPasswordlessLoginByUsername":           true,
+	"lrauthentication.PutResendEmailVerification":               true,
+	"lrauthentication.DeleteAuthDeleteAccountEmailConfirmation": true,
+	"lraccount.PutManageAccountInvalidateVerificationEmail":     true,
+	"phoneauthentication.GetPhoneSendOTP":                       true,
+	"phoneauthentication.PutPhoneNumberUpdate":                  true,
+	"phoneauthentication.PutResetPhoneIDVerification":           true,
+	"smartlogin.GetSmartLoginByEmail":                           true,
+	"smartlogin.GetSmartLoginByUsername":                        true,
+	"mfa.GetMFAReAuthenticate":                                  true,
+	"mfa.PutMFAUpdatePhoneNumber":                               true,
+	"mfa.PutMFAUpdatePhoneNumberByToken":                        true,
+}
+
+// DefaultRetryPolicy returns a RetryPolicy retrying idempotent calls up to 3 attempts on
+// 429 and 5xx gateway errors, backing off exponentially from 200ms up to 5s with 20% jitter.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:      3,
+		InitialBackoff:   200 * time.Millisecond,
+		MaxBackoff:       5 * time.Second,
+		Multiplier:       2,
+		Jitter:           0.2,
+		RetryStatusCodes: []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+		RetryMethods:     []Method{Get, Put, Delete},
+	}
+}
+
+// Validate reports whether the policy is usable, returning an error describing
+// the first invalid setting otherwise.
+func (p *RetryPolicy) Validate() error {
+	switch {
+	case p.MaxAttempts < 1:
+		return fmt.Errorf("retry policy MaxAttempts must be at least 1, got %d", p.MaxAttempts)
+	case p.InitialBackoff < 0 || p.MaxBackoff < 0:
+		return errors.New("retry policy backoffs must not be negative")
+	case p.MaxBackoff > 0 && p.InitialBackoff > p.MaxBackoff:
+		return errors.New("retry policy InitialBackoff must not exceed MaxBackoff")
+	case p.Multiplier != 0 && p.Multiplier < 1:
+		return fmt.Errorf("retry policy Multiplier must be at least 1, got %g", p.Multiplier)
+	case p.Jitter < 0 || p.Jitter > 1:
+		return fmt.Errorf("retry policy Jitter must be between 0 and 1, got %g", p.Jitter)
+	}
+	return nil
+}
+
+// retries reports whether req, a call to endpoint, may be retried.
+func (p *RetryPolicy) retries(req *http.Request, endpoint Endpoint) bool {
+	if !p.retriesMethod(req.Method) {
+		return false
+	}
+	if p.Retryable != nil {
+		return p.Retryable(req, endpoint)
+	}
+	return !NonRetryableOperations[endpoint.Operation]
+}
+
+// retriesMethod reports whether requests with the given method may be retried.
+func (p *RetryPolicy) retriesMethod(method string) bool {
+	for _, m := range p.RetryMethods {
+		if string(m) == method {
+			return true
+		}
+	}
+	return false
+}
+
+// retriesStatus reports whether a response with the given status code should be retried.
+func (p *RetryPolicy) retriesStatus(code int) bool {
+	for _, c := range p.RetryStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry, counting from 1.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	delay := float64(p.InitialBackoff) * math.Pow(multiplier, float64(retry-1))
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		delay -= delay * jitter * rand.Float64()
+	}
+	return time.Duration(delay)
+}
+
+// retryAfter parses the Retry-After header of res, which holds either a number of
+// seconds or an HTTP date. It returns false if the header is missing or malformed.
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+	value := res.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// doWithRetry makes the API call through the Client's middleware, retrying it according
+// to the Client's RetryPolicy. It returns the number of attempts made.
+func (c *Client) doWithRetry(req *http.Request, endpoint Endpoint) (*http.Response, int, error) {
+	roundTrip := c.roundTrip()
+	policy := c.Retry
+	if policy == nil || policy.MaxAttempts <= 1 || !policy.retries(req, endpoint) {
+		res, err := roundTrip(req)
+		return res, 1, err
+	}
+
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		attemptReq, err := rewindRequest(req, attempt)
+		if err != nil {
+			return nil, attempt - 1, err
+		}
+
+		res, err := roundTrip(attemptReq)
+		if attempt >= policy.MaxAttempts || !shouldRetry(ctx, policy, res, err) {
+			return res, attempt, err
+		}
+
+		delay := policy.backoff(attempt)
+		if after, ok := retryAfter(res); ok {
+			// The server asked for a longer pause than we are willing to wait,
+			// return its response rather than blocking the caller.
+			if policy.MaxBackoff > 0 && after > policy.MaxBackoff {
+				return res, attempt, err
+			}
+			delay = after
+		}
+		if res != nil {
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		if c.Logger != nil {
+			status := 0
+			if res != nil {
+				status = res.StatusCode
+			}
+			c.Logger.DebugContext(ctx, "Retrying LoginRadius API call", "method", req.Method, "path", req.URL.Path,
+				"attempt", attempt, "status", status, "delay", delay)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, attempt, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether an attempt that returned res and err should be retried.
+func shouldRetry(ctx context.Context, policy *RetryPolicy, res *http.Response, err error) bool {
+	if err != nil {
+		// Errors caused by the caller's own context, the rate limiter or the circuit breaker are final.
+		var rateLimitErr *RateLimitError
+		var circuitErr *CircuitOpenError
+		return ctx.Err() == nil && !errors.Is(err, context.Canceled) &&
+			!errors.As(err, &rateLimitErr) && !errors.As(err, &circuitErr)
+	}
+	return policy.retriesStatus(res.StatusCode)
+}
+
+// rewindRequest returns the request to send for the given attempt. The first attempt
+// uses req as is, later attempts get a copy with a fresh body.
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 1 {
+		return req, nil
+	}
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+	return clone, nil
+}
@@ -0,0 +1,161 @@
+package httprutils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func flakyServer(failures int32, status int, header map[string]string) (*httptest.Server, *int32) {
+	var calls int32
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= failures {
+			for k, v := range header {
+				w.Header().Set(k, v)
+			}
+			w.WriteHeader(status)
+			return
+		}
+		fmt.Fprintln(w, "{\"message\": \"success\"}")
+	}))
+	return stub, &calls
+}
+
+func testRetryPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 10 * time.Millisecond
+	return policy
+}
+
+func TestRetryOnServiceUnavailable(t *testing.T) {
+	t.Parallel()
+	stub, calls := flakyServer(2, http.StatusServiceUnavailable, nil)
+	defer stub.Close()
+
+	client := &Client{HTTPClient: NetClient, Retry: testRetryPolicy()}
+	res, err := client.Send(Request{Method: Get, URL: stub.URL})
+	if err != nil {
+		t.Fatalf("Expected the call to succeed after retries, got: %v", err)
+	}
+	if res.StatusCode != 200 || *calls != 3 {
+		t.Errorf("Expected 3 attempts ending in 200, got %d attempts and status %d", *calls, res.StatusCode)
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	t.Parallel()
+	stub, calls := flakyServer(5, http.StatusBadGateway, nil)
+	defer stub.Close()
+
+	client := &Client{HTTPClient: NetClient, Retry: testRetryPolicy()}
+	_, err := client.Send(Request{Method: Get, URL: stub.URL})
+	if err == nil {
+		t.Error("Expected an error once retries are exhausted")
+	}
+	if *calls != 3 {
+		t.Errorf("Expected 3 attempts, got %d", *calls)
+	}
+}
+
+func TestRetrySkipsNonIdempotentMethods(t *testing.T) {
+	t.Parallel()
+	stub, calls := flakyServer(1, http.StatusServiceUnavailable, nil)
+	defer stub.Close()
+
+	body, _ := EncodeBody(map[string]string{"Email": "test@example.com"})
+	client := &Client{HTTPClient: NetClient, Retry: testRetryPolicy()}
+	_, err := client.Send(Request{Method: Post, URL: stub.URL, Body: body})
+	if err == nil {
+		t.Error("Expected the POST call to fail without retrying")
+	}
+	if *calls != 1 {
+		t.Errorf("Expected a single attempt, got %d", *calls)
+	}
+}
+
+func TestRetrySkipsSideEffects(t *testing.T) {
+	t.Parallel()
+	stub, calls := flakyServer(5, http.StatusServiceUnavailable, nil)
+	defer stub.Close()
+
+	client := &Client{HTTPClient: NetClient, Retry: testRetryPolicy()}
+	sendOTP := Request{Method: Get, URL: stub.URL, Endpoint: Endpoint{Operation: "phoneauthentication.GetPhoneSendOTP", PathTemplate: "/identity/v2/auth/login/passwordlesslogin/otp"}}
+	if _, err := client.Send(sendOTP); err == nil || atomic.LoadInt32(calls) != 1 {
+		t.Errorf("Expected a single attempt of a call sending an OTP, got %d", *calls)
+	}
+
+	client.Retry.Retryable = func(*http.Request, Endpoint) bool { return true }
+	client.Send(sendOTP)
+	if atomic.LoadInt32(calls) != 4 {
+		t.Errorf("Expected Retryable to override the default endpoints, got %d attempts", *calls)
+	}
+}
+
+func TestRetryResendsBody(t *testing.T) {
+	t.Parallel()
+	var bodies []string
+	var calls int32
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf := make([]byte, r.ContentLength)
+		r.Body.Read(buf)
+		bodies = append(bodies, string(buf))
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "{}")
+	}))
+	defer stub.Close()
+
+	body, _ := EncodeBody(map[string]string{"FirstName": "test"})
+	client := &Client{HTTPClient: NetClient, Retry: testRetryPolicy()}
+	if _, err := client.Send(Request{Method: Put, URL: stub.URL, Body: body}); err != nil {
+		t.Fatalf("Expected the PUT call to succeed after a retry, got: %v", err)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] || bodies[0] == "" {
+		t.Errorf("Expected the same body on both attempts, got %q", bodies)
+	}
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	t.Parallel()
+	stub, calls := flakyServer(1, http.StatusTooManyRequests, map[string]string{"Retry-After": "60"})
+	defer stub.Close()
+
+	client := &Client{HTTPClient: NetClient, Retry: testRetryPolicy()}
+	start := time.Now()
+	_, err := client.Send(Request{Method: Get, URL: stub.URL})
+	if err == nil || *calls != 1 {
+		t.Errorf("Expected a Retry-After beyond MaxBackoff to stop retries, got %d attempts", *calls)
+	}
+	if time.Since(start) > time.Second {
+		t.Error("Expected the client not to wait for a Retry-After beyond MaxBackoff")
+	}
+}
+
+func TestRetryStopsOnContextCancel(t *testing.T) {
+	t.Parallel()
+	stub, _ := flakyServer(5, http.StatusServiceUnavailable, nil)
+	defer stub.Close()
+
+	policy := testRetryPolicy()
+	policy.InitialBackoff = time.Second
+	policy.MaxBackoff = time.Second
+	client := &Client{HTTPClient: NetClient, Retry: policy}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := client.SendWithContext(ctx, Request{Method: Get, URL: stub.URL})
+	if err == nil {
+		t.Error("Expected an error when the context expires during backoff")
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Error("Expected the backoff to be interrupted by the context")
+	}
+}
@@ -0,0 +1,97 @@
+package httprutils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+// Tracer traces the API calls of a Client. It is a small subset of tracing libraries
+// such as OpenTelemetry, which an adapter implements to avoid a hard dependency:
+//
+//	type otelTracer struct{ trace.Tracer }
+//
+//	func (t otelTracer) Start(ctx context.Context, name string) (context.Context, httprutils.Span) {
+//		ctx, span := t.Tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+//		return ctx, otelSpan{span}
+//	}
+//
+//	func (t otelTracer) Inject(ctx context.Context, header http.Header) {
+//		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+//	}
+type Tracer interface {
+	// Start starts a span named name, child of the span held by ctx, and returns
+	// a context holding the new span.
+	Start(ctx context.Context, name string) (context.Context, Span)
+
+	// Inject writes the trace context held by ctx into the headers of an outgoing
+	// request, e.g. as W3C traceparent and tracestate headers.
+	Inject(ctx context.Context, header http.Header)
+}
+
+// Span is a single traced API call.
+type Span interface {
+	// SetAttribute sets an attribute of the span, whose value is a string or an int.
+	SetAttribute(key string, value interface{})
+
+	// RecordError marks the span as failed with err.
+	RecordError(err error)
+
+	// End completes the span.
+	End()
+}
+
+// Attributes set on the spans of API calls. They follow the OpenTelemetry semantic
+// conventions for HTTP clients where one exists.
+const (
+	AttributeHTTPMethod           = "http.request.method"
+	AttributeURLTemplate          = "url.template"
+	AttributeStatusCode           = "http.response.status_code"
+	AttributeResendCount          = "http.request.resend_count"
+	AttributeErrorType            = "error.type"
+	AttributeLoginradiusErrorCode = "loginradius.error_code"
+)
+
+// name returns the name of the endpoint in spans and metrics: the SDK method if
+// known, the method and path template otherwise.
+func (e Endpoint) name(method Method) string {
+	if e.Operation != "" {
+		return e.Operation
+	}
+	if e.PathTemplate != "" {
+		return string(method) + " " + e.PathTemplate
+	}
+	return string(method)
+}
+
+// recordSpan sets the attributes describing the outcome of a call on its span.
+func recordSpan(span Span, request Request, response *Response, attempts int, err error) {
+	span.SetAttribute(AttributeHTTPMethod, string(request.Method))
+	if request.Endpoint.PathTemplate != "" {
+		span.SetAttribute(AttributeURLTemplate, request.Endpoint.PathTemplate)
+	}
+	if attempts > 1 {
+		span.SetAttribute(AttributeResendCount, attempts-1)
+	}
+	if response != nil {
+		span.SetAttribute(AttributeStatusCode, response.StatusCode)
+	}
+	if err == nil {
+		return
+	}
+
+	var apiErr *lrerror.APIError
+	if errors.As(err, &apiErr) {
+		span.SetAttribute(AttributeStatusCode, apiErr.StatusCode)
+		if apiErr.ErrorCode != 0 {
+			span.SetAttribute(AttributeLoginradiusErrorCode, apiErr.ErrorCode)
+		}
+	}
+	var lrErr lrerror.Error
+	if errors.As(err, &lrErr) {
+		span.SetAttribute(AttributeErrorType, lrErr.Code())
+	}
+	span.RecordError(err)
+}
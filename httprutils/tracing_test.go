@@ -0,0 +1,98 @@
+package httprutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+type testSpan struct {
+	name       string
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+func (s *testSpan) SetAttribute(key string, value interface{}) { s.attributes[key] = value }
+func (s *testSpan) RecordError(err error)                      { s.err = err }
+func (s *testSpan) End()                                       { s.ended = true }
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	span := &testSpan{name: name, attributes: map[string]interface{}{}}
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+func (t *testTracer) Inject(ctx context.Context, header http.Header) {
+	header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+}
+
+func TestTracerRecordsCall(t *testing.T) {
+	stub, _ := flakyServer(1, http.StatusServiceUnavailable, nil)
+	defer stub.Close()
+
+	tracer := &testTracer{}
+	client := &Client{HTTPClient: http.DefaultClient, Retry: testRetryPolicy(), Tracer: tracer}
+	_, err := client.Send(Request{
+		Method:   Get,
+		URL:      stub.URL + "/identity/v2/manage/account/test-uid",
+		Endpoint: Endpoint{Operation: "lraccount.GetManageAccountProfilesByUid", PathTemplate: "/identity/v2/manage/account/{uid}"},
+	})
+	if err != nil {
+		t.Fatalf("Expected the call to succeed, got: %v", err)
+	}
+
+	if len(tracer.spans) != 1 {
+		t.Fatalf("Expected a single span per call, got %d", len(tracer.spans))
+	}
+	span := tracer.spans[0]
+	if span.name != "lraccount.GetManageAccountProfilesByUid" || !span.ended || span.err != nil {
+		t.Errorf("Expected an ended span named after the SDK method, got %+v", span)
+	}
+	expected := map[string]interface{}{
+		AttributeHTTPMethod:  "GET",
+		AttributeURLTemplate: "/identity/v2/manage/account/{uid}",
+		AttributeStatusCode:  http.StatusOK,
+		AttributeResendCount: 1,
+	}
+	for key, value := range expected {
+		if span.attributes[key] != value {
+			t.Errorf("Expected attribute %s to be %v, got %v", key, value, span.attributes[key])
+		}
+	}
+}
+
+func TestTracerRecordsErrorAndPropagates(t *testing.T) {
+	var traceparent string
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"ErrorCode": 906, "Message": "Access token is invalid"}`)
+	}))
+	defer stub.Close()
+
+	tracer := &testTracer{}
+	client := &Client{HTTPClient: http.DefaultClient, Tracer: tracer}
+	_, err := client.Send(Request{Method: Post, URL: stub.URL, Endpoint: Endpoint{PathTemplate: "/identity/v2/auth/login"}})
+
+	span := tracer.spans[0]
+	if traceparent == "" {
+		t.Error("Expected the trace context to be propagated in the request headers")
+	}
+	if span.name != "POST /identity/v2/auth/login" || !errors.Is(span.err, err) {
+		t.Errorf("Expected a failed span named after the path template, got %+v", span)
+	}
+	if span.attributes[AttributeStatusCode] != http.StatusUnauthorized || span.attributes[AttributeLoginradiusErrorCode] != 906 ||
+		span.attributes[AttributeErrorType] != lrerror.CodeLoginradiusRespondedWithError {
+		t.Errorf("Expected the status and LoginRadius error code to be recorded, got %v", span.attributes)
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 	"strconv"
@@ -20,7 +19,8 @@ import (
 
 // Generates a SOTT through the methods described here:
 // https://docs.loginradius.com/api/v2/user-registration/sott
-func Generate(key string, secret string, timeDifference string, startTime string, endTime string) string {
+// An EncryptionError is returned if the SOTT cannot be encrypted with the secret.
+func Generate(key string, secret string, timeDifference string, startTime string, endTime string) (string, error) {
 	var plainText = ""
 	if startTime != "" && endTime != "" {
 		plainText = startTime + "#" + key + "#" + endTime
@@ -33,11 +33,14 @@ func Generate(key string, secret string, timeDifference string, startTime string
 		plainText=generatePlainText(key,"10")
 	}
 	
-	tempToken := encrypt(plainText, secret)
+	tempToken, err := encrypt(plainText, secret)
+	if err != nil {
+		return "", err
+	}
 	token := strings.Replace(tempToken, "-", "+", -1)
 	readyToken := strings.Replace(token, "_", "/", -1)
 	hash := getMD5Hash(readyToken)
-	return readyToken + "*" + hash
+	return readyToken + "*" + hash, nil
 	
 }
 
@@ -62,7 +65,7 @@ func pKCS5Padding(src []byte, blockSize int) []byte {
 	return append(src, padtext...)
 }
 
-func encrypt(plaintext string, secret string) string {
+func encrypt(plaintext string, secret string) (string, error) {
 	initVector := "tu89geji340t89u2"
 	salt := make([]byte, 8)
 	password := pbkdf2.Key([]byte(secret), salt, 10000, 32, sha1.New)
@@ -71,8 +74,7 @@ func encrypt(plaintext string, secret string) string {
 
 	block, err := aes.NewCipher(password)
 	if err != nil {
-		err = lrerror.New("EncryptionError", "Error occurred during sott encryption", err)
-		log.Println(err.Error())
+		return "", lrerror.New("EncryptionError", "Error occurred during sott encryption", err)
 	}
 
 	iv := []byte(initVector)
@@ -83,15 +85,12 @@ func encrypt(plaintext string, secret string) string {
 	encrypted := make([]byte, len(origData))
 	blockMode.CryptBlocks(encrypted, origData)
 
-	return base64.URLEncoding.EncodeToString(encrypted)
+	return base64.URLEncoding.EncodeToString(encrypted), nil
 }
 
 func getMD5Hash(text string) string {
 	hasher := md5.New()
-	_, err := hasher.Write([]byte(text))
-	if err != nil {
-		err = lrerror.New("MD5HashError", "Error creating hash for SOTT", err)
-		log.Println(err.Error())
-	}
+	// Writing to a hash never returns an error
+	hasher.Write([]byte(text))
 	return hex.EncodeToString(hasher.Sum(nil))
 }
@@ -3,6 +3,7 @@ package loginradius
 
 import (
 	"errors"
+	"time"
 
 	"github.com/LoginRadius/go-sdk/httprutils"
 	"github.com/LoginRadius/go-sdk/lrerror"
@@ -11,12 +12,33 @@ import (
 // domain is the default domain for API calls to Loginradius
 const domain = "https://api.loginradius.com"
 
+// DefaultConfigURL is the default base URL of the configuration CDN serving the Get Configurations API
+const DefaultConfigURL = "https://config.lrcontent.com"
+
 // Loginradius struct holds context for intializing the Loginradius client and the domain for API calls
 // Domain can be changed after intialization
 type Loginradius struct {
 	Context     *Context
 	Domain      string
 	HTTPRClient *httprutils.Client
+
+	// ConfigURL is the base URL of the configuration CDN, DefaultConfigURL by default
+	ConfigURL string
+
+	// Region, when set, is sent with every API call but those to the configuration CDN in the region query parameter
+	Region string
+
+	// Resolver, when set, overrides the base URL of API families, see EndpointResolver
+	Resolver EndpointResolver
+
+	// AllowHTTP allows New to accept base URLs using plain HTTP rather than HTTPS
+	AllowHTTP bool
+
+	// SignRequests signs API calls requiring the API secret instead of sending it, see Sign
+	SignRequests bool
+
+	// SigningExpiry is how long signed API calls are valid, DefaultSigningExpiry when zero
+	SigningExpiry time.Duration
 }
 
 // Config struct contains Loginradius credentials and is used when initalizing the Loginradius API client struct
@@ -48,7 +70,7 @@ func NewLoginradius(cfg *Config, optionalArgs ...map[string]string) (*Loginradiu
 
 	if cfg.ApiKey == "" || cfg.ApiSecret == "" {
 		errMsg := "Must initialize Loginradius client with ApiKey and ApiSecret"
-		err := lrerror.New("IntializationError", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeInitialization, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -70,5 +92,6 @@ func NewLoginradius(cfg *Config, optionalArgs ...map[string]string) (*Loginradiu
 		Context:     &ctx,
 		Domain:      domain,
 		HTTPRClient: httprutils.TimeoutClient,
+		ConfigURL:   DefaultConfigURL,
 	}, nil
 }
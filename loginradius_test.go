@@ -3,8 +3,11 @@ package loginradius
 import (
 	"errors"
 	"reflect"
+	"strconv"
+	"sync"
 	"testing"
 
+	"github.com/LoginRadius/go-sdk/httprutils"
 	"github.com/LoginRadius/go-sdk/lrerror"
 )
 
@@ -32,3 +35,41 @@ func TestNewLoginradius(t *testing.T) {
 		}
 	}
 }
+
+func TestWithToken(t *testing.T) {
+	lrclient, _ := NewLoginradius(&config)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(token string) {
+			defer wg.Done()
+			userClient := lrclient.WithToken(token)
+			req, err := userClient.NewGetReqWithToken("/identity/v2/auth/account")
+			if err != nil || req.Headers["Authorization"] != "Bearer "+token {
+				t.Errorf("Expected the request to use token %s, got: %v", token, req)
+			}
+		}(strconv.Itoa(i))
+	}
+	wg.Wait()
+
+	if lrclient.Context.Token != "" {
+		t.Errorf("Expected WithToken to leave the original client unchanged, got token %s", lrclient.Context.Token)
+	}
+	if _, err := lrclient.NewGetReqWithToken("/identity/v2/auth/account"); err == nil {
+		t.Error("Expected the original client to have no token")
+	}
+}
+
+func TestAddApiCredentialsToReqHeader(t *testing.T) {
+	lrclient, _ := NewLoginradius(&config)
+	req := lrclient.NewGetReq("/api/v2/webhook", map[string]string{"apisecret": "abc123"})
+	lrclient.AddApiCredentialsToReqHeader(req)
+
+	if req.Headers["X-LoginRadius-ApiSecret"] != "abc123" || req.QueryParams["apisecret"] != "" {
+		t.Errorf("Expected the API secret to be moved to the headers, got %v and %v", req.Headers, req.QueryParams)
+	}
+	if _, ok := httprutils.URLEncodedHeader["X-LoginRadius-ApiSecret"]; ok {
+		t.Error("Expected the shared headers of the httprutils package to be left unchanged")
+	}
+}
@@ -0,0 +1,125 @@
+// Package cachetest holds the contract test suite of lrcache.Cache implementations.
+package cachetest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/lrcache"
+)
+
+// TTL is the time to live of the values expiring in the suite. Implementations must
+// expire values with at least this precision.
+const TTL = 100 * time.Millisecond
+
+// Run runs the contract test suite against the caches returned by newCache, which must
+// return an empty cache on every call.
+func Run(t *testing.T, newCache func() lrcache.Cache) {
+	t.Run("GetMissing", func(t *testing.T) {
+		get(t, newCache(), "missing", nil)
+	})
+
+	t.Run("SetAndGet", func(t *testing.T) {
+		cache := newCache()
+		set(t, cache, "key", []byte("value"), time.Minute)
+		get(t, cache, "key", []byte("value"))
+	})
+
+	t.Run("EmptyValue", func(t *testing.T) {
+		cache := newCache()
+		set(t, cache, "key", []byte{}, time.Minute)
+		get(t, cache, "key", []byte{})
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		cache := newCache()
+		set(t, cache, "key", []byte("first"), time.Minute)
+		set(t, cache, "key", []byte("second"), time.Minute)
+		get(t, cache, "key", []byte("second"))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		cache := newCache()
+		set(t, cache, "key", []byte("value"), time.Minute)
+		set(t, cache, "other", []byte("value"), time.Minute)
+		if err := cache.Delete(context.Background(), "key"); err != nil {
+			t.Fatalf("Expected Delete to succeed, got: %v", err)
+		}
+		get(t, cache, "key", nil)
+		get(t, cache, "other", []byte("value"))
+		if err := cache.Delete(context.Background(), "missing"); err != nil {
+			t.Errorf("Expected deleting a missing key to succeed, got: %v", err)
+		}
+	})
+
+	t.Run("Expiry", func(t *testing.T) {
+		cache := newCache()
+		set(t, cache, "expiring", []byte("value"), TTL)
+		set(t, cache, "lasting", []byte("value"), time.Minute)
+		set(t, cache, "permanent", []byte("value"), 0)
+		time.Sleep(2 * TTL)
+		get(t, cache, "expiring", nil)
+		get(t, cache, "lasting", []byte("value"))
+		get(t, cache, "permanent", []byte("value"))
+	})
+
+	t.Run("ValuesAreCopied", func(t *testing.T) {
+		cache := newCache()
+		value := []byte("value")
+		set(t, cache, "key", value, time.Minute)
+		value[0] = 'x'
+		got, _, _ := cache.Get(context.Background(), "key")
+		if len(got) > 0 {
+			got[0] = 'y'
+		}
+		get(t, cache, "key", []byte("value"))
+	})
+
+	t.Run("Concurrency", func(t *testing.T) {
+		cache := newCache()
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ctx := context.Background()
+				for j := 0; j < 50; j++ {
+					key := fmt.Sprintf("key-%d", j%10)
+					cache.Set(ctx, key, []byte(key), time.Minute)
+					if value, ok, err := cache.Get(ctx, key); err != nil || (ok && string(value) != key) {
+						t.Errorf("Expected %s to hold its own value, got %q, %v", key, value, err)
+					}
+					cache.Delete(ctx, key)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
+
+func set(t *testing.T, cache lrcache.Cache, key string, value []byte, ttl time.Duration) {
+	t.Helper()
+	if err := cache.Set(context.Background(), key, value, ttl); err != nil {
+		t.Fatalf("Expected setting %s to succeed, got: %v", key, err)
+	}
+}
+
+// get checks that key holds expected, or nothing if expected is nil.
+func get(t *testing.T, cache lrcache.Cache, key string, expected []byte) {
+	t.Helper()
+	value, ok, err := cache.Get(context.Background(), key)
+	switch {
+	case err != nil:
+		t.Errorf("Expected getting %s to succeed, got: %v", key, err)
+	case expected == nil && ok:
+		t.Errorf("Expected %s to hold no value, got %q", key, value)
+	case expected != nil && !ok:
+		t.Errorf("Expected %s to hold %q, got no value", key, expected)
+	case expected != nil && !bytes.Equal(value, expected):
+		t.Errorf("Expected %s to hold %q, got %q", key, expected, value)
+	}
+}
@@ -0,0 +1,132 @@
+// Package lrcache defines the Cache backend the SDK keeps cached data in, such as the
+// responses of the read cache of httprutils, the JWKS documents of lrjwt, and the
+// identities and role list of lrmiddleware.
+//
+// The SDK caches data in an in-memory LRU by default. To share cached data across the
+// replicas of a service, implement Cache on top of a distributed store, and check the
+// implementation with the contract test suite of the cachetest package:
+//
+//	func TestRedisCache(t *testing.T) {
+//		cachetest.Run(t, func() lrcache.Cache { return newRedisCache(t) })
+//	}
+package lrcache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultMaxEntries is the number of values an LRU holds when created with no bound.
+const DefaultMaxEntries = 1000
+
+// A Cache stores values by key until they expire. Keys are chosen by the SDK, prefixed
+// with the name of the package storing them, and never hold access tokens or API secrets.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored under key, and false if there is none or it has expired.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+
+	// Set stores value under key for ttl, replacing any value stored under key.
+	// Values set with a ttl of zero or less do not expire.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+
+	// Delete removes the value stored under key, if any.
+	Delete(ctx context.Context, key string) error
+}
+
+// LRU is an in-memory Cache holding up to a fixed number of values, evicting the least
+// recently used ones first. It is safe for concurrent use.
+type LRU struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+	now     func() time.Time
+}
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewLRU returns an LRU holding up to maxEntries values, DefaultMaxEntries if maxEntries
+// is zero or less.
+func NewLRU(maxEntries int) *LRU {
+	if maxEntries <= 0 {
+		maxEntries = DefaultMaxEntries
+	}
+	return &LRU{maxEntries: maxEntries, entries: map[string]*list.Element{}, order: list.New(), now: time.Now}
+}
+
+// Get returns a copy of the value stored under key.
+func (c *LRU) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := elem.Value.(*lruEntry)
+	if !entry.expires.IsZero() && !c.now().Before(entry.expires) {
+		c.remove(elem)
+		return nil, false, nil
+	}
+	c.order.MoveToFront(elem)
+	return append([]byte(nil), entry.value...), true, nil
+}
+
+// Set stores a copy of value under key, evicting the least recently used value if the LRU is full.
+func (c *LRU) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	entry := &lruEntry{key: key, value: append([]byte(nil), value...)}
+	if ttl > 0 {
+		entry.expires = c.now().Add(ttl)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return nil
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+	return nil
+}
+
+// Delete removes the value stored under key.
+func (c *LRU) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+	return nil
+}
+
+// Len returns the number of values held, including expired values not evicted yet.
+func (c *LRU) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Purge removes all values.
+func (c *LRU) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[string]*list.Element{}
+	c.order.Init()
+}
+
+// remove removes elem. c.mu must be held.
+func (c *LRU) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*lruEntry).key)
+}
@@ -0,0 +1,36 @@
+package lrcache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/lrcache"
+	"github.com/LoginRadius/go-sdk/lrcache/cachetest"
+)
+
+func TestLRU(t *testing.T) {
+	cachetest.Run(t, func() lrcache.Cache { return lrcache.NewLRU(100) })
+}
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	cache := lrcache.NewLRU(2)
+	cache.Set(ctx, "a", []byte("a"), time.Minute)
+	cache.Set(ctx, "b", []byte("b"), time.Minute)
+	cache.Get(ctx, "a")
+	cache.Set(ctx, "c", []byte("c"), time.Minute)
+
+	for key, expected := range map[string]bool{"a": true, "b": false, "c": true} {
+		if _, ok, _ := cache.Get(ctx, key); ok != expected {
+			t.Errorf("Expected %s to be held: %t, got %t", key, expected, ok)
+		}
+	}
+	if cache.Len() != 2 {
+		t.Errorf("Expected the LRU to hold 2 values, got %d", cache.Len())
+	}
+	cache.Purge()
+	if cache.Len() != 0 {
+		t.Errorf("Expected the LRU to be empty after Purge, got %d", cache.Len())
+	}
+}
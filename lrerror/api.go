@@ -0,0 +1,102 @@
+package lrerror
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// CodeLoginradiusRespondedWithError is the Code of an APIError.
+const CodeLoginradiusRespondedWithError = "LoginradiusRespondedWithError"
+
+// LoginRadius error codes matched by the sentinel errors below. See the LoginRadius
+// API documentation for the complete list of error codes.
+const (
+	ErrorCodeExpiredToken  = 905
+	ErrorCodeInvalidToken  = 906
+	ErrorCodeAccountLocked = 1048
+	ErrorCodeMFARequired   = 1094
+)
+
+// Sentinel errors for common LoginRadius error codes, to be used with errors.Is:
+//
+//	res, err := lrauthentication.Loginradius(lrauthentication.Loginradius{lrclient}).GetAuthReadProfilesByToken()
+//	if errors.Is(err, lrerror.ErrInvalidToken) {
+//		// ask the user to log in again
+//	}
+var (
+	ErrInvalidToken  = &Sentinel{Name: "InvalidToken", ErrorCodes: []int{ErrorCodeInvalidToken, ErrorCodeExpiredToken}}
+	ErrAccountLocked = &Sentinel{Name: "AccountLocked", ErrorCodes: []int{ErrorCodeAccountLocked}}
+	ErrMFARequired   = &Sentinel{Name: "MFARequired", ErrorCodes: []int{ErrorCodeMFARequired}}
+)
+
+// An APIError is returned when LoginRadius responds to an API call with a non-2xx
+// status. It carries the HTTP status and headers of the response along with the
+// LoginRadius error parsed from its body, and satisfies the Error interface:
+// Code returns CodeLoginradiusRespondedWithError and OrigErr the raw response body.
+//
+// Use errors.As to access it from an error returned by an API method:
+//
+//	var apiErr *lrerror.APIError
+//	if errors.As(err, &apiErr) {
+//		log.Println("Error:", apiErr.StatusCode, apiErr.ErrorCode, apiErr.Description)
+//	}
+type APIError struct {
+	// The LoginRadius error parsed from the response body. Its fields are left empty
+	// when the body is not a LoginRadius error, e.g. when a proxy responded instead.
+	// Note that the Message field is shadowed by the Message method, read it through
+	// ErrorResponse.Message.
+	ErrorResponse
+
+	// HTTP status code of the response.
+	StatusCode int
+
+	// Headers of the response.
+	Headers map[string][]string
+
+	// Raw body of the response.
+	Body []byte
+}
+
+// NewAPIError returns an APIError for a response with the given status code, headers and body.
+func NewAPIError(statusCode int, headers map[string][]string, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Headers:    headers,
+		Body:       body,
+	}
+	json.Unmarshal(body, &apiErr.ErrorResponse)
+	return apiErr
+}
+
+// Error returns the string representation of the error.
+//
+// Satisfies the error interface.
+func (e *APIError) Error() string {
+	return SprintError(e.Code(), e.Message(), "", e.OrigErr())
+}
+
+// Code returns the short phrase depicting the classification of the error.
+func (e *APIError) Code() string {
+	return CodeLoginradiusRespondedWithError
+}
+
+// Message returns the error details message.
+func (e *APIError) Message() string {
+	return "Received error response from Loginradius"
+}
+
+// OrigErr returns the raw response body as an error.
+func (e *APIError) OrigErr() error {
+	return errors.New(string(e.Body))
+}
+
+// Is reports whether the APIError matches target, which is true for a Sentinel listing
+// the APIError's ErrorCode, and for ErrRateLimited if its status is 429 Too Many Requests.
+func (e *APIError) Is(target error) bool {
+	if target == ErrRateLimited {
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	sentinel, ok := target.(*Sentinel)
+	return ok && sentinel.matches(e.Code(), e.ErrorCode)
+}
@@ -1,6 +1,81 @@
 // Package lrerr represents API error interface accessors for the SDK.
 package lrerror
 
+import "fmt"
+
+// Codes of the errors returned by the SDK itself, as opposed to errors returned by LoginRadius.
+const (
+	CodeMissingToken   = "MissingTokenErr"
+	CodeValidation     = "ValidationError"
+	CodeEncoding       = "EncodingError"
+	CodeMakeRequest    = "MakeRequestError"
+	CodeInitialization = "IntializationError"
+	CodeSecretInURL    = "SecretInURLError"
+	CodeSignature      = "SignatureError"
+	CodeRateLimited    = "RateLimitedError"
+	CodeCircuitOpen    = "CircuitOpenError"
+	CodeTokenStore     = "TokenStoreError"
+)
+
+// Sentinel errors for the codes above, to be used with errors.Is:
+//
+//	res, err := lrauthentication.Loginradius(lrauthentication.Loginradius{lrclient}).GetAuthReadProfilesByToken()
+//	if errors.Is(err, lrerror.ErrMissingToken) {
+//		// initialize the client with an access token
+//	}
+var (
+	ErrMissingToken   = &Sentinel{Name: "MissingToken", Code: CodeMissingToken}
+	ErrValidation     = &Sentinel{Name: "Validation", Code: CodeValidation}
+	ErrEncoding       = &Sentinel{Name: "Encoding", Code: CodeEncoding}
+	ErrMakeRequest    = &Sentinel{Name: "MakeRequest", Code: CodeMakeRequest}
+	ErrInitialization = &Sentinel{Name: "Initialization", Code: CodeInitialization}
+	ErrSecretInURL    = &Sentinel{Name: "SecretInURL", Code: CodeSecretInURL}
+	ErrSignature      = &Sentinel{Name: "Signature", Code: CodeSignature}
+	ErrCircuitOpen    = &Sentinel{Name: "CircuitOpen", Code: CodeCircuitOpen}
+	ErrTokenStore     = &Sentinel{Name: "TokenStore", Code: CodeTokenStore}
+
+	// ErrRateLimited also matches APIErrors with status 429 Too Many Requests.
+	ErrRateLimited = &Sentinel{Name: "RateLimited", Code: CodeRateLimited}
+)
+
+// A Sentinel classifies errors returned by the SDK for use with errors.Is. An error
+// matches a Sentinel if its Code equals the Sentinel's Code and, for an APIError, if
+// its LoginRadius ErrorCode is one of the Sentinel's ErrorCodes. Fields left empty
+// on the Sentinel are not compared.
+type Sentinel struct {
+	Name       string
+	Code       string
+	ErrorCodes []int
+}
+
+// Error satisfies the error interface.
+func (s *Sentinel) Error() string {
+	if len(s.ErrorCodes) > 0 {
+		return fmt.Sprintf("%s: LoginRadius error codes %v", s.Name, s.ErrorCodes)
+	}
+	return fmt.Sprintf("%s: %s", s.Name, s.Code)
+}
+
+// matches reports whether an error with the given code and LoginRadius error code
+// matches the Sentinel.
+func (s *Sentinel) matches(code string, errorCode int) bool {
+	if s.Code == "" && len(s.ErrorCodes) == 0 {
+		return false
+	}
+	if s.Code != "" && s.Code != code {
+		return false
+	}
+	if len(s.ErrorCodes) == 0 {
+		return true
+	}
+	for _, c := range s.ErrorCodes {
+		if c == errorCode {
+			return true
+		}
+	}
+	return false
+}
+
 // An Error wraps lower level errors with code, message and an original error.
 // The underlying concrete error type may also satisfy other interfaces which
 // can be to used to obtain more specific information about the error.
@@ -8,6 +83,10 @@ package lrerror
 // Calling Error() or String() will always include the full information about
 // an error based on its underlying type.
 //
+// Errors created by New and NewBatchError implement Unwrap() []error, so the
+// standard errors.Is and errors.As see through to the original errors, e.g.
+// errors.Is(err, context.DeadlineExceeded) for a request that timed out.
+//
 // Example:
 //
 //     output, err := loginradius.GetAuthVerifyEmail()
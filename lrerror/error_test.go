@@ -0,0 +1,64 @@
+package lrerror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	body := []byte(`{"Description": "The user is locked", "ErrorCode": 1048, "Message": "Account is locked", "IsProviderError": false}`)
+	apiErr := NewAPIError(403, map[string][]string{"Content-Type": {"application/json"}}, body)
+
+	if apiErr.StatusCode != 403 || apiErr.ErrorCode != 1048 || apiErr.Description != "The user is locked" || apiErr.ErrorResponse.Message != "Account is locked" {
+		t.Errorf("APIError parsed incorrectly: %+v", apiErr)
+	}
+	if apiErr.OrigErr().Error() != string(body) {
+		t.Errorf("Expected OrigErr to hold the response body, got: %v", apiErr.OrigErr())
+	}
+
+	var err error = fmt.Errorf("calling LoginRadius: %w", apiErr)
+	if !errors.Is(err, ErrAccountLocked) || errors.Is(err, ErrInvalidToken) {
+		t.Error("APIError matched the wrong sentinel")
+	}
+	var target *APIError
+	if !errors.As(err, &target) || target != apiErr {
+		t.Error("Expected errors.As to find the APIError")
+	}
+	if _, ok := interface{}(apiErr).(Error); !ok {
+		t.Error("Expected APIError to satisfy Error")
+	}
+}
+
+func TestNewAPIErrorNonJSONBody(t *testing.T) {
+	apiErr := NewAPIError(502, nil, []byte("Bad Gateway"))
+	if apiErr.ErrorCode != 0 || apiErr.StatusCode != 502 {
+		t.Errorf("APIError parsed incorrectly: %+v", apiErr)
+	}
+	if errors.Is(apiErr, ErrInvalidToken) {
+		t.Error("Expected an APIError without an error code not to match any sentinel")
+	}
+}
+
+func TestErrorUnwrap(t *testing.T) {
+	err := New(CodeMakeRequest, "Error making the request", fmt.Errorf("dial: %w", context.DeadlineExceeded))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Error("Expected errors.Is to find the original error")
+	}
+	if !errors.Is(err, ErrMakeRequest) || errors.Is(err, ErrEncoding) || errors.Is(err, ErrInvalidToken) {
+		t.Error("Error matched the wrong sentinel")
+	}
+
+	opErr := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	batch := NewBatchError("BatchedErrors", "multiple errors occurred", []error{errors.New("first"), opErr})
+	var target *net.OpError
+	if !errors.As(batch, &target) || target != opErr {
+		t.Error("Expected errors.As to find an error in the batch")
+	}
+	wrapped := New(CodeValidation, "Error validating params", batch)
+	if !errors.As(wrapped, &target) || !errors.Is(wrapped, ErrValidation) {
+		t.Error("Expected nested errors to be unwrapped")
+	}
+}
@@ -108,6 +108,19 @@ func (b baseError) OrigErrs() []error {
 	return b.errs
 }
 
+// Unwrap returns the original errors, allowing errors.Is and errors.As to
+// inspect every error wrapped by a baseError.
+func (b baseError) Unwrap() []error {
+	return b.errs
+}
+
+// Is reports whether the error matches target, which is true for a Sentinel with
+// the error's code.
+func (b baseError) Is(target error) bool {
+	sentinel, ok := target.(*Sentinel)
+	return ok && sentinel.matches(b.code, 0)
+}
+
 // An error list that satisfies the golang interface
 type errorList []error
 
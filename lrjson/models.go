@@ -0,0 +1,171 @@
+package lrjson
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/httprutils"
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+// DecodeAs unmarshals the body of a response returned by an API method into a value of type T.
+// T is typically one of the models in this package, e.g.:
+//
+//	res, err := lrauthentication.Loginradius(lrauthentication.Loginradius{lrclient}).PostAuthLoginByEmail(login)
+//	if err != nil {
+//		// handle error
+//	}
+//	session, err := lrjson.DecodeAs[lrjson.AccessToken](res)
+//	if err != nil {
+//		// handle error
+//	}
+//	uid := session.Profile.UID
+//
+// The note on DynamicUnmarshal still applies: fields added to a LoginRadius response
+// are ignored until they are added to the model, and fields whose type does not match
+// the model cause an error.
+func DecodeAs[T any](res *httprutils.Response) (T, error) {
+	var decoded T
+	if res == nil {
+		err := lrerror.New(lrerror.CodeEncoding, "Error decoding the response body", errors.New("nil response"))
+		return decoded, err
+	}
+
+	body := res.OrigBody
+	if body == nil {
+		body = []byte(res.Body)
+	}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return decoded, lrerror.New(lrerror.CodeEncoding, "Error decoding the response body", err)
+	}
+	return decoded, nil
+}
+
+// Decode returns the body of the response of an API call decoded into a T, or the error of
+// the call. It backs the typed variants of the API methods, such as GetRolesListTyped, and
+// can be used the same way for calls without one:
+//
+//	codes, err := lrjson.Decode[lrjson.BackupCodes](mfa.Loginradius{lrclient}.GetMFABackUpCodeByUID(queries))
+func Decode[T any](res *httprutils.Response, err error) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := DecodeAs[T](res)
+	if err != nil {
+		return nil, err
+	}
+	return &decoded, nil
+}
+
+// Profile is the user profile returned by the Authentication, Account, Social and
+// Phone Authentication APIs.
+type Profile = AuthProfile
+
+// List holds the paginated arrays returned by LoginRadius, such as the role list or
+// the custom objects of an account.
+type List[T any] struct {
+	Data  []T `json:"Data"`
+	Count int `json:"Count"`
+}
+
+// AccessToken holds the session returned by the login, registration, social and token
+// management APIs, as well as the result of validating or refreshing an access token.
+// Profile is only set by the APIs returning the user's profile along with the token.
+type AccessToken struct {
+	AccessToken  string       `json:"access_token"`
+	RefreshToken string       `json:"refresh_token"`
+	ExpiresIn    time.Time    `json:"expires_in"`
+	Profile      *AuthProfile `json:"Profile"`
+}
+
+// MFALogin is returned by the MFA login APIs. When the user must complete a second
+// factor, SecondFactorAuthentication is set and AccessToken is empty.
+type MFALogin struct {
+	AccessToken
+	SecondFactorAuthentication *SecondFactorAuthentication `json:"SecondFactorAuthentication"`
+}
+
+// SecondFactorAuthentication describes the second factor a user has to complete to
+// finish an MFA login, and the authenticators already configured for the user.
+type SecondFactorAuthentication struct {
+	SecondFactorAuthenticationToken string     `json:"SecondFactorAuthenticationToken"`
+	ExpireIn                        time.Time  `json:"ExpireIn"`
+	QRCode                          string     `json:"QRCode"`
+	ManualEntryCode                 string     `json:"ManualEntryCode"`
+	IsGoogleAuthenticatorVerified   bool       `json:"IsGoogleAuthenticatorVerified"`
+	IsAuthenticatorVerified         bool       `json:"IsAuthenticatorVerified"`
+	IsEmailOtpAuthenticatorVerified bool       `json:"IsEmailOtpAuthenticatorVerified"`
+	IsOTPAuthenticatorVerified      bool       `json:"IsOTPAuthenticatorVerified"`
+	OTPPhoneNo                      string     `json:"OTPPhoneNo"`
+	OTPStatus                       *SMSStatus `json:"OTPStatus"`
+}
+
+// SMSStatus is the delivery status of an OTP sent by SMS.
+type SMSStatus struct {
+	AccountSid string `json:"AccountSid"`
+	Sid        string `json:"Sid"`
+}
+
+// BackupCodes is returned by the MFA backup code APIs.
+type BackupCodes struct {
+	BackUpCodes []string `json:"BackUpCodes"`
+}
+
+// Role is a role and its permissions, as returned by the Roles Management APIs.
+type Role struct {
+	Name        string          `json:"Name"`
+	Permissions map[string]bool `json:"Permissions"`
+}
+
+// RoleList is returned by GetRolesList.
+type RoleList = List[Role]
+
+// UserRoles holds the roles assigned to an account, as returned by GetRolesByUID.
+type UserRoles struct {
+	Roles []string `json:"Roles"`
+}
+
+// RoleContext holds the roles and permissions of an account within a role context.
+type RoleContext struct {
+	Context               string    `json:"Context"`
+	Roles                 []string  `json:"Roles"`
+	AdditionalPermissions []string  `json:"AdditionalPermissions"`
+	Expiration            time.Time `json:"Expiration"`
+}
+
+// RoleContextList is returned by GetContextRolesPermissions.
+type RoleContextList = List[RoleContext]
+
+// CustomObjectRecord is a custom object record of an account. The record's data is left
+// undecoded in CustomObject, since its schema is defined per LoginRadius site.
+type CustomObjectRecord struct {
+	ID           string          `json:"Id"`
+	UID          string          `json:"Uid"`
+	IsActive     bool            `json:"IsActive"`
+	IsDeleted    bool            `json:"IsDeleted"`
+	DateCreated  time.Time       `json:"DateCreated"`
+	DateModified time.Time       `json:"DateModified"`
+	CustomObject json.RawMessage `json:"CustomObject"`
+}
+
+// CustomObjectList is returned by the APIs listing the custom objects of an account.
+type CustomObjectList = List[CustomObjectRecord]
+
+// Identities is returned by GetManageAccountIdentitiesByEmail.
+type Identities = List[AuthProfile]
+
+// PostResponse is returned by APIs acknowledging a change or a sent message.
+type PostResponse struct {
+	IsPosted bool `json:"IsPosted"`
+}
+
+// DeleteResponse is returned by APIs deleting a resource.
+type DeleteResponse struct {
+	IsDeleted bool `json:"IsDeleted"`
+}
+
+// ExistResponse is returned by the email, username and phone availability APIs.
+type ExistResponse struct {
+	IsExist bool `json:"IsExist"`
+}
@@ -0,0 +1,83 @@
+package lrjson
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/LoginRadius/go-sdk/httprutils"
+)
+
+func TestDecodeAsAccessToken(t *testing.T) {
+	res := &httprutils.Response{
+		StatusCode: 200,
+		Body:       `{"access_token": "abcd", "refresh_token": "efgh", "expires_in": "2019-08-11T05:48:07.2512616Z", "Profile": ` + profile + `}`,
+	}
+	session, err := DecodeAs[AccessToken](res)
+	if err != nil {
+		t.Fatalf("Error decoding access token: %v", err)
+	}
+	if session.AccessToken != "abcd" || session.RefreshToken != "efgh" || session.ExpiresIn.Year() != 2019 {
+		t.Errorf("Access token decoded incorrectly: %+v", session)
+	}
+	if session.Profile == nil || session.Profile.UserName != "JohnSmith" {
+		t.Errorf("Profile decoded incorrectly: %+v", session.Profile)
+	}
+}
+
+func TestDecodeAsMFALogin(t *testing.T) {
+	res := &httprutils.Response{
+		StatusCode: 200,
+		OrigBody: []byte(`{"SecondFactorAuthentication": {
+			"SecondFactorAuthenticationToken": "2fa-token",
+			"ExpireIn": "2019-08-11T05:48:07.2512616Z",
+			"IsGoogleAuthenticatorVerified": true,
+			"OTPPhoneNo": "+1*******890"
+		}}`),
+	}
+	login, err := DecodeAs[MFALogin](res)
+	if err != nil {
+		t.Fatalf("Error decoding MFA login: %v", err)
+	}
+	if login.AccessToken.AccessToken != "" || login.SecondFactorAuthentication == nil {
+		t.Fatalf("Expected a pending second factor, got %+v", login)
+	}
+	if login.SecondFactorAuthentication.SecondFactorAuthenticationToken != "2fa-token" || !login.SecondFactorAuthentication.IsGoogleAuthenticatorVerified {
+		t.Errorf("Second factor decoded incorrectly: %+v", login.SecondFactorAuthentication)
+	}
+}
+
+func TestDecodeAsRoleList(t *testing.T) {
+	res := &httprutils.Response{
+		StatusCode: 200,
+		Body:       `{"Data": [{"Name": "admin", "Permissions": {"edit": true}}, {"Name": "reader", "Permissions": null}], "Count": 2}`,
+	}
+	roles, err := DecodeAs[RoleList](res)
+	if err != nil {
+		t.Fatalf("Error decoding role list: %v", err)
+	}
+	if roles.Count != 2 || len(roles.Data) != 2 || !roles.Data[0].Permissions["edit"] {
+		t.Errorf("Role list decoded incorrectly: %+v", roles)
+	}
+}
+
+func TestDecodeAsError(t *testing.T) {
+	if _, err := DecodeAs[AccessToken](nil); err == nil {
+		t.Error("Expected an error decoding a nil response")
+	}
+	res := &httprutils.Response{StatusCode: 200, Body: `{"access_token": 1234}`}
+	if _, err := DecodeAs[AccessToken](res); err == nil {
+		t.Error("Expected an error decoding a mismatched field type")
+	}
+}
+
+func TestDecode(t *testing.T) {
+	res := &httprutils.Response{StatusCode: 200, Body: `{"Roles": ["admin"]}`}
+	roles, err := Decode[UserRoles](res, nil)
+	if err != nil || len(roles.Roles) != 1 || roles.Roles[0] != "admin" {
+		t.Errorf("User roles decoded incorrectly: %+v, %v", roles, err)
+	}
+	callErr := errors.New("call failed")
+	if roles, err := Decode[UserRoles](nil, callErr); roles != nil || err != callErr {
+		t.Errorf("Expected the error of the call, got %+v, %v", roles, err)
+	}
+}
@@ -0,0 +1,66 @@
+package lrjwt
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Claims holds the claims of a verified token.
+type Claims struct {
+	Issuer    string      `json:"iss"`
+	Subject   string      `json:"sub"`
+	Audience  Audience    `json:"aud"`
+	ExpiresAt NumericDate `json:"exp"`
+	NotBefore NumericDate `json:"nbf"`
+	IssuedAt  NumericDate `json:"iat"`
+	ID        string      `json:"jti"`
+
+	// Raw holds every claim of the token, including the registered claims above.
+	Raw map[string]interface{} `json:"-"`
+
+	// Remote is true for claims returned by a Fallback rather than read from a JWT.
+	Remote bool `json:"-"`
+}
+
+// NumericDate is a JWT timestamp, the number of seconds since the Unix epoch.
+type NumericDate struct {
+	time.Time
+}
+
+// UnmarshalJSON decodes a NumericDate from a JSON number.
+func (d *NumericDate) UnmarshalJSON(data []byte) error {
+	var seconds float64
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return err
+	}
+	d.Time = time.Unix(0, int64(seconds*float64(time.Second)))
+	return nil
+}
+
+// Audience is the aud claim, which JWTs hold either as a string or as an array of strings.
+type Audience []string
+
+// UnmarshalJSON decodes an Audience from a JSON string or array of strings.
+func (a *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = Audience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+// Contains reports whether audience is one of the token's audiences.
+func (a Audience) Contains(audience string) bool {
+	for _, aud := range a {
+		if aud == audience {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,261 @@
+package lrjwt
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/httprutils"
+	"github.com/LoginRadius/go-sdk/lrcache"
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+// errKeyUnavailable is returned when the JWKS document cannot be fetched, letting Verify
+// hand the token to the Fallback.
+var errKeyUnavailable = errors.New("signing key unavailable")
+
+// minRefreshInterval limits how often an unknown key id triggers a refetch of the JWKS
+// document, so that tokens with made-up key ids cannot flood the JWKS endpoint.
+const minRefreshInterval = 30 * time.Second
+
+// jwksKeyPrefix prefixes the keys of the JWKS documents in the cache backend.
+const jwksKeyPrefix = "lrjwt:jwks:"
+
+// cachedJWKS is a JWKS document as stored in the cache backend.
+type cachedJWKS struct {
+	Document json.RawMessage
+	Expires  time.Time
+}
+
+// jwksCache caches the RSA keys of a JWKS document by key id. The document itself is also
+// stored in backend if set, so that it is fetched once for all the replicas sharing it.
+//
+// The document is fetched without holding mu, by a single call at a time whose result
+// the calls needing it meanwhile share. While the JWKS endpoint is unavailable, the
+// expired keys keep being used and the fetch is retried at most every minRefreshInterval.
+type jwksCache struct {
+	url     string
+	client  *httprutils.Client
+	ttl     time.Duration
+	backend lrcache.Cache
+	now     func() time.Time
+
+	mu          sync.Mutex
+	keys        map[string]*rsa.PublicKey
+	expires     time.Time
+	lastFetched time.Time // when the document was last fetched, successfully or not
+	lastErr     error     // the error of the last fetch, nil if it succeeded
+	fetch       *jwksFetch
+}
+
+// jwksFetch is a fetch of the JWKS document in flight.
+type jwksFetch struct {
+	done      chan struct{}
+	err       error
+	cancelled bool // the fetch failed because the context of its caller ended
+}
+
+func newJWKSCache(url string, client *httprutils.Client, ttl time.Duration, backend lrcache.Cache) *jwksCache {
+	return &jwksCache{url: url, client: client, ttl: ttl, backend: backend, now: time.Now}
+}
+
+// key returns the key with the given id, fetching the JWKS document if it is not
+// cached, has expired, or does not contain the key.
+func (c *jwksCache) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	c.mu.Lock()
+	expired := c.keys == nil || c.now().After(c.expires)
+	c.mu.Unlock()
+	if expired {
+		// Keep using the expired keys if the JWKS endpoint is unavailable.
+		if err := c.update(ctx, true); err != nil && !c.hasKeys() {
+			return nil, err
+		}
+	}
+	if key, ok := c.lookup(kid); ok {
+		return key, nil
+	}
+
+	// The key may have been rotated in since the document was fetched.
+	c.mu.Lock()
+	due := c.now().Sub(c.lastFetched) >= minRefreshInterval
+	c.mu.Unlock()
+	if due {
+		if err := c.update(ctx, false); err != nil {
+			return nil, err
+		}
+		if key, ok := c.lookup(kid); ok {
+			return key, nil
+		}
+	}
+	return nil, invalid("Token is signed by an unknown key", errors.New("unknown kid: "+kid))
+}
+
+// hasKeys reports whether keys were ever fetched.
+func (c *jwksCache) hasKeys() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.keys != nil
+}
+
+// lookup returns the key with the given id. A token without a key id matches the
+// only key of a JWKS document holding a single key.
+func (c *jwksCache) lookup(kid string) (*rsa.PublicKey, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if kid == "" && len(c.keys) == 1 {
+		for _, key := range c.keys {
+			return key, true
+		}
+	}
+	key, ok := c.keys[kid]
+	return key, ok
+}
+
+// update replaces the cached keys, loading them from the backend first if fromBackend
+// is set. It shares the fetch in flight if there is one, and returns the error of the
+// last fetch rather than fetching again if it failed less than minRefreshInterval ago.
+// Callers already holding keys do not wait for a fetch in flight.
+func (c *jwksCache) update(ctx context.Context, fromBackend bool) error {
+	for {
+		c.mu.Lock()
+		f := c.fetch
+		switch {
+		case f != nil && c.keys != nil:
+			c.mu.Unlock()
+			return nil
+		case f == nil && c.lastErr != nil && c.now().Sub(c.lastFetched) < minRefreshInterval:
+			err := c.lastErr
+			c.mu.Unlock()
+			return err
+		case f == nil:
+			f = &jwksFetch{done: make(chan struct{})}
+			c.fetch = f
+			previous := c.lastFetched
+			c.lastFetched = c.now()
+			c.mu.Unlock()
+
+			f.err = c.load(ctx, fromBackend)
+			c.mu.Lock()
+			c.fetch = nil
+			if f.cancelled = f.err != nil && ctx.Err() != nil; f.cancelled {
+				// The fetch was cut short by the context of its caller, which says
+				// nothing of the JWKS endpoint.
+				c.lastFetched = previous
+			} else {
+				c.lastErr = f.err
+			}
+			c.mu.Unlock()
+			close(f.done)
+			return f.err
+		}
+		c.mu.Unlock()
+
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if !f.cancelled {
+			return f.err
+		}
+	}
+}
+
+// load returns the keys of the JWKS document stored in the backend if fromBackend is
+// set, or fetches the document if the backend does not hold it, and caches them.
+func (c *jwksCache) load(ctx context.Context, fromBackend bool) error {
+	if fromBackend && c.backend != nil {
+		data, ok, err := c.backend.Get(ctx, c.backendKey())
+		var cached cachedJWKS
+		if err == nil && ok && json.Unmarshal(data, &cached) == nil && c.now().Before(cached.Expires) {
+			if keys, err := parseJWKS(cached.Document); err == nil {
+				c.mu.Lock()
+				c.keys, c.expires = keys, cached.Expires
+				c.mu.Unlock()
+				return nil
+			}
+		}
+	}
+	return c.refresh(ctx)
+}
+
+// backendKey returns the key of the JWKS document in the cache backend.
+func (c *jwksCache) backendKey() string {
+	sum := sha256.Sum256([]byte(c.url))
+	return jwksKeyPrefix + hex.EncodeToString(sum[:])
+}
+
+// refresh fetches the JWKS document and replaces the cached keys.
+func (c *jwksCache) refresh(ctx context.Context) error {
+	res, err := c.client.SendWithContext(ctx, httprutils.Request{
+		Method:   httprutils.Get,
+		URL:      c.url,
+		Headers:  httprutils.JSONHeader,
+		Endpoint: httprutils.Endpoint{Operation: "lrjwt.FetchJWKS"},
+	})
+	if err != nil {
+		return lrerror.New(CodeInvalidToken, "Error fetching the JWKS document", errors.Join(errKeyUnavailable, err))
+	}
+
+	keys, err := parseJWKS(res.OrigBody)
+	if err != nil {
+		return lrerror.New(CodeInvalidToken, "Error parsing the JWKS document", errors.Join(errKeyUnavailable, err))
+	}
+	c.mu.Lock()
+	c.keys = keys
+	c.expires = c.now().Add(c.ttl)
+	expires := c.expires
+	c.mu.Unlock()
+	if c.backend != nil {
+		if data, err := json.Marshal(cachedJWKS{Document: res.OrigBody, Expires: expires}); err == nil {
+			c.backend.Set(ctx, c.backendKey(), data, c.ttl)
+		}
+	}
+	return nil
+}
+
+// parseJWKS parses the RSA signing keys of a JWKS document.
+func parseJWKS(data []byte) (map[string]*rsa.PublicKey, error) {
+	var document struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+	for _, jwk := range document.Keys {
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		keys[jwk.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no RSA signing keys in JWKS document")
+	}
+	return keys, nil
+}
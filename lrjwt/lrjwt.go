@@ -0,0 +1,225 @@
+// Package lrjwt validates LoginRadius-issued JWT access tokens locally, without calling
+// the LoginRadius API for every token.
+//
+// RS256 tokens are verified against the keys published in a JWKS document, which is cached
+// and refetched on expiry or when a token is signed by an unknown key, so key rotation
+// is picked up automatically. HS256 tokens are verified with a shared secret.
+//
+// Tokens that cannot be verified locally, such as the opaque access tokens LoginRadius
+// issues by default, can be handed to a Fallback, typically RemoteFallback which calls
+// the Validate Access Token API.
+package lrjwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	lr "github.com/LoginRadius/go-sdk"
+	lrauthentication "github.com/LoginRadius/go-sdk/api/authentication"
+	"github.com/LoginRadius/go-sdk/httprutils"
+	"github.com/LoginRadius/go-sdk/lrcache"
+	"github.com/LoginRadius/go-sdk/lrerror"
+	"github.com/LoginRadius/go-sdk/lrjson"
+)
+
+// CodeInvalidToken is the code of the errors returned for tokens failing validation.
+const CodeInvalidToken = "InvalidTokenError"
+
+// errNotJWT is returned for tokens that are not JWTs, letting Verify hand them to the Fallback.
+var errNotJWT = errors.New("token is not a JWT")
+
+// Config holds the settings of a Verifier. At least one of JWKSURL and HMACSecret must be set.
+type Config struct {
+	// JWKSURL is the URL of the JWKS document holding the keys of RS256 tokens.
+	JWKSURL string
+
+	// HMACSecret is the secret of HS256 tokens.
+	HMACSecret []byte
+
+	// Issuer and Audience, when set, must match the iss and aud claims of the token.
+	Issuer   string
+	Audience string
+
+	// ClockSkew is the leeway allowed when checking the exp, nbf and iat claims.
+	ClockSkew time.Duration
+
+	// JWKSCacheTTL is how long the JWKS document is cached, 1 hour by default.
+	JWKSCacheTTL time.Duration
+
+	// HTTPClient is used to fetch the JWKS document, httprutils.TimeoutClient by default.
+	HTTPClient *httprutils.Client
+
+	// Cache, when set, stores the JWKS document, e.g. so that the replicas of a service
+	// share it rather than each fetching it.
+	Cache lrcache.Cache
+
+	// Fallback, when set, validates the tokens that are not JWTs, or whose signing key
+	// cannot be fetched. It is never called for a JWT failing validation.
+	Fallback Fallback
+}
+
+// A Fallback validates a token the Verifier cannot verify locally.
+type Fallback func(ctx context.Context, token string) (*Claims, error)
+
+// A Verifier validates LoginRadius JWTs. It is safe for concurrent use.
+type Verifier struct {
+	cfg  Config
+	jwks *jwksCache
+	now  func() time.Time
+}
+
+// NewVerifier returns a Verifier configured with cfg.
+func NewVerifier(cfg Config) (*Verifier, error) {
+	if cfg.JWKSURL == "" && len(cfg.HMACSecret) == 0 {
+		errMsg := "Must configure a JWKS URL or an HMAC secret to verify tokens"
+		return nil, lrerror.New(lrerror.CodeInitialization, errMsg, errors.New(errMsg))
+	}
+	if cfg.JWKSCacheTTL <= 0 {
+		cfg.JWKSCacheTTL = time.Hour
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = httprutils.TimeoutClient
+	}
+
+	v := &Verifier{cfg: cfg, now: time.Now}
+	if cfg.JWKSURL != "" {
+		v.jwks = newJWKSCache(cfg.JWKSURL, cfg.HTTPClient, cfg.JWKSCacheTTL, cfg.Cache)
+	}
+	return v, nil
+}
+
+// Verify validates token and returns its claims. It checks the signature, the exp, nbf
+// and iat claims, and the iss and aud claims if the Verifier is configured with them.
+func (v *Verifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	claims, err := v.verify(ctx, token)
+	if err == nil {
+		return claims, nil
+	}
+	if v.cfg.Fallback != nil && (errors.Is(err, errNotJWT) || errors.Is(err, errKeyUnavailable)) {
+		return v.cfg.Fallback(ctx, token)
+	}
+	return nil, err
+}
+
+func (v *Verifier) verify(ctx context.Context, token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, invalid("Malformed token", errNotJWT)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, invalid("Malformed token header", errNotJWT)
+	}
+
+	signed := []byte(parts[0] + "." + parts[1])
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, invalid("Malformed token signature", err)
+	}
+
+	switch header.Alg {
+	case "RS256":
+		if v.jwks == nil {
+			return nil, invalid("RS256 tokens require a JWKS URL", errors.New("no JWKS URL configured"))
+		}
+		key, err := v.jwks.key(ctx, header.Kid)
+		if err != nil {
+			return nil, err
+		}
+		digest := sha256.Sum256(signed)
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return nil, invalid("Invalid token signature", err)
+		}
+	case "HS256":
+		if len(v.cfg.HMACSecret) == 0 {
+			return nil, invalid("HS256 tokens require an HMAC secret", errors.New("no HMAC secret configured"))
+		}
+		mac := hmac.New(sha256.New, v.cfg.HMACSecret)
+		mac.Write(signed)
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return nil, invalid("Invalid token signature", errors.New("signature mismatch"))
+		}
+	default:
+		return nil, invalid("Unsupported token algorithm", errors.New("unsupported alg: "+header.Alg))
+	}
+
+	claims := &Claims{}
+	if err := decodeSegment(parts[1], claims); err != nil {
+		return nil, invalid("Malformed token claims", err)
+	}
+	if err := decodeSegment(parts[1], &claims.Raw); err != nil {
+		return nil, invalid("Malformed token claims", err)
+	}
+	if err := v.validateClaims(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// validateClaims checks the registered claims of a token whose signature is valid.
+func (v *Verifier) validateClaims(claims *Claims) error {
+	now := v.now()
+	skew := v.cfg.ClockSkew
+
+	if claims.ExpiresAt.IsZero() {
+		return invalid("Token has no expiry", errors.New("missing exp claim"))
+	}
+	if now.After(claims.ExpiresAt.Add(skew)) {
+		return invalid("Token has expired", errors.New("exp claim is in the past"))
+	}
+	if !claims.NotBefore.IsZero() && now.Add(skew).Before(claims.NotBefore.Time) {
+		return invalid("Token is not valid yet", errors.New("nbf claim is in the future"))
+	}
+	if !claims.IssuedAt.IsZero() && now.Add(skew).Before(claims.IssuedAt.Time) {
+		return invalid("Token was issued in the future", errors.New("iat claim is in the future"))
+	}
+	if v.cfg.Issuer != "" && claims.Issuer != v.cfg.Issuer {
+		return invalid("Token has an unexpected issuer", errors.New("iss claim mismatch: "+claims.Issuer))
+	}
+	if v.cfg.Audience != "" && !claims.Audience.Contains(v.cfg.Audience) {
+		return invalid("Token has an unexpected audience", errors.New("aud claim mismatch"))
+	}
+	return nil
+}
+
+// RemoteFallback returns a Fallback validating tokens with the Validate Access Token API
+// of the given client. The returned Claims only hold the token's expiry, in ExpiresAt.
+func RemoteFallback(client *lr.Loginradius) Fallback {
+	return func(ctx context.Context, token string) (*Claims, error) {
+		res, err := lrauthentication.Loginradius{Client: client.WithToken(token)}.GetAuthValidateAccessTokenWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		validated, err := lrjson.DecodeAs[lrjson.AccessToken](res)
+		if err != nil {
+			return nil, err
+		}
+		return &Claims{ExpiresAt: NumericDate{validated.ExpiresIn}, Remote: true}, nil
+	}
+}
+
+// decodeSegment decodes a base64url encoded JWT segment into v.
+func decodeSegment(segment string, v interface{}) error {
+	decoded, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(decoded, v)
+}
+
+// invalid returns an error for a token failing validation.
+func invalid(message string, origErr error) error {
+	return lrerror.New(CodeInvalidToken, message, origErr)
+}
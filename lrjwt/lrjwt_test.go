@@ -0,0 +1,307 @@
+package lrjwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/lrcache"
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+type testKeySet struct {
+	mu      sync.Mutex
+	keys    map[string]*rsa.PrivateKey
+	fetches int32
+	down    bool
+}
+
+func (ks *testKeySet) add(t *testing.T, kid string) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.keys[kid] = key
+	return key
+}
+
+func (ks *testKeySet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	atomic.AddInt32(&ks.fetches, 1)
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	if ks.down {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	var jwks struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	for kid, key := range ks.keys {
+		jwks.Keys = append(jwks.Keys, map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	json.NewEncoder(w).Encode(jwks)
+}
+
+func sign(t *testing.T, alg, kid string, key interface{}, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		digest := sha256.Sum256([]byte(signed))
+		var err error
+		signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+	case []byte:
+		mac := hmac.New(sha256.New, k)
+		mac.Write([]byte(signed))
+		signature = mac.Sum(nil)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func validClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"iss":   "https://example.hub.loginradius.com",
+		"aud":   "test-app",
+		"sub":   "test-uid",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"iat":   time.Now().Unix(),
+		"email": "test@example.com",
+	}
+}
+
+func initVerifier(t *testing.T, fallback Fallback) (*Verifier, *testKeySet, *httptest.Server) {
+	keySet := &testKeySet{keys: map[string]*rsa.PrivateKey{}}
+	stub := httptest.NewServer(keySet)
+	verifier, err := NewVerifier(Config{
+		JWKSURL:    stub.URL,
+		HMACSecret: []byte("abcd1234"),
+		Issuer:     "https://example.hub.loginradius.com",
+		Audience:   "test-app",
+		ClockSkew:  time.Minute,
+		Fallback:   fallback,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return verifier, keySet, stub
+}
+
+func TestVerifyRS256(t *testing.T) {
+	verifier, keySet, stub := initVerifier(t, nil)
+	defer stub.Close()
+	key := keySet.add(t, "key-1")
+
+	claims, err := verifier.Verify(context.Background(), sign(t, "RS256", "key-1", key, validClaims()))
+	if err != nil {
+		t.Fatalf("Expected a valid token, got: %v", err)
+	}
+	if claims.Subject != "test-uid" || claims.Raw["email"] != "test@example.com" || claims.Remote {
+		t.Errorf("Claims decoded incorrectly: %+v", claims)
+	}
+
+	// The cached JWKS document is reused for the next token.
+	verifier.Verify(context.Background(), sign(t, "RS256", "key-1", key, validClaims()))
+	if keySet.fetches != 1 {
+		t.Errorf("Expected the JWKS document to be fetched once, got %d fetches", keySet.fetches)
+	}
+}
+
+func TestVerifySharedJWKSCache(t *testing.T) {
+	keySet := &testKeySet{keys: map[string]*rsa.PrivateKey{}}
+	stub := httptest.NewServer(keySet)
+	defer stub.Close()
+	key := keySet.add(t, "key-1")
+
+	cache := lrcache.NewLRU(10)
+	for i := 0; i < 2; i++ {
+		verifier, err := NewVerifier(Config{JWKSURL: stub.URL, Cache: cache})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := verifier.Verify(context.Background(), sign(t, "RS256", "key-1", key, validClaims())); err != nil {
+			t.Fatalf("Expected a valid token, got: %v", err)
+		}
+	}
+	if keySet.fetches != 1 {
+		t.Errorf("Expected the verifiers to share the JWKS document, got %d fetches", keySet.fetches)
+	}
+}
+
+func TestVerifyKeyRotation(t *testing.T) {
+	verifier, keySet, stub := initVerifier(t, nil)
+	defer stub.Close()
+	oldKey := keySet.add(t, "key-1")
+	if _, err := verifier.Verify(context.Background(), sign(t, "RS256", "key-1", oldKey, validClaims())); err != nil {
+		t.Fatalf("Expected a valid token, got: %v", err)
+	}
+
+	newKey := keySet.add(t, "key-2")
+	verifier.jwks.mu.Lock()
+	verifier.jwks.lastFetched = time.Now().Add(-minRefreshInterval)
+	verifier.jwks.mu.Unlock()
+	if _, err := verifier.Verify(context.Background(), sign(t, "RS256", "key-2", newKey, validClaims())); err != nil {
+		t.Fatalf("Expected a token signed by a rotated key to be valid, got: %v", err)
+	}
+
+	// Unknown key ids do not refetch the JWKS document more than once per interval.
+	_, err := verifier.Verify(context.Background(), sign(t, "RS256", "key-3", newKey, validClaims()))
+	if err == nil || keySet.fetches != 2 {
+		t.Errorf("Expected an unknown key to be rejected without refetching, got %v after %d fetches", err, keySet.fetches)
+	}
+}
+
+func TestVerifyJWKSOutage(t *testing.T) {
+	verifier, keySet, stub := initVerifier(t, nil)
+	defer stub.Close()
+	key := keySet.add(t, "key-1")
+	token := sign(t, "RS256", "key-1", key, validClaims())
+	if _, err := verifier.Verify(context.Background(), token); err != nil {
+		t.Fatalf("Expected a valid token, got: %v", err)
+	}
+
+	keySet.mu.Lock()
+	keySet.down = true
+	keySet.mu.Unlock()
+	verifier.jwks.mu.Lock()
+	verifier.jwks.expires = time.Now().Add(-time.Second)
+	verifier.jwks.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := verifier.Verify(context.Background(), token); err != nil {
+				t.Errorf("Expected the expired keys to be used during the outage, got: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if fetches := atomic.LoadInt32(&keySet.fetches); fetches != 2 {
+		t.Errorf("Expected a failed fetch not to be retried before the refresh interval, got %d fetches", fetches)
+	}
+
+	keySet.mu.Lock()
+	keySet.down = false
+	keySet.mu.Unlock()
+	verifier.jwks.mu.Lock()
+	verifier.jwks.lastFetched = time.Now().Add(-minRefreshInterval)
+	verifier.jwks.mu.Unlock()
+	verifier.Verify(context.Background(), token)
+	verifier.Verify(context.Background(), token)
+	if fetches := atomic.LoadInt32(&keySet.fetches); fetches != 3 || !time.Now().Before(verifier.jwks.expires) {
+		t.Errorf("Expected the keys to be fetched again once the refresh interval elapsed, got %d fetches", fetches)
+	}
+}
+
+func TestVerifyHS256(t *testing.T) {
+	verifier, _, stub := initVerifier(t, nil)
+	defer stub.Close()
+
+	if _, err := verifier.Verify(context.Background(), sign(t, "HS256", "", []byte("abcd1234"), validClaims())); err != nil {
+		t.Errorf("Expected a valid token, got: %v", err)
+	}
+	if _, err := verifier.Verify(context.Background(), sign(t, "HS256", "", []byte("wrong"), validClaims())); err == nil {
+		t.Error("Expected a token signed with another secret to be rejected")
+	}
+}
+
+func TestVerifyRejectsInvalidClaims(t *testing.T) {
+	verifier, keySet, stub := initVerifier(t, nil)
+	defer stub.Close()
+	key := keySet.add(t, "key-1")
+
+	cases := map[string]func(map[string]interface{}){
+		"expired":        func(c map[string]interface{}) { c["exp"] = time.Now().Add(-2 * time.Minute).Unix() },
+		"no expiry":      func(c map[string]interface{}) { delete(c, "exp") },
+		"not yet valid":  func(c map[string]interface{}) { c["nbf"] = time.Now().Add(2 * time.Minute).Unix() },
+		"wrong issuer":   func(c map[string]interface{}) { c["iss"] = "https://attacker.example.com" },
+		"wrong audience": func(c map[string]interface{}) { c["aud"] = []string{"other-app"} },
+	}
+	for name, modify := range cases {
+		claims := validClaims()
+		modify(claims)
+		_, err := verifier.Verify(context.Background(), sign(t, "RS256", "key-1", key, claims))
+		if err == nil || err.(lrerror.Error).Code() != CodeInvalidToken {
+			t.Errorf("Expected a %s token to be rejected, got: %v", name, err)
+		}
+	}
+
+	// Tokens within the clock skew are accepted.
+	claims := validClaims()
+	claims["exp"] = time.Now().Add(-30 * time.Second).Unix()
+	if _, err := verifier.Verify(context.Background(), sign(t, "RS256", "key-1", key, claims)); err != nil {
+		t.Errorf("Expected a token expired within the clock skew to be valid, got: %v", err)
+	}
+}
+
+func TestVerifyFallback(t *testing.T) {
+	var fallbackCalls int32
+	fallback := func(ctx context.Context, token string) (*Claims, error) {
+		atomic.AddInt32(&fallbackCalls, 1)
+		return &Claims{Remote: true}, nil
+	}
+	verifier, keySet, stub := initVerifier(t, fallback)
+	key := keySet.add(t, "key-1")
+
+	claims, err := verifier.Verify(context.Background(), "9c3208ae-2848-4ac5-baef-41dd4103e263")
+	if err != nil || !claims.Remote {
+		t.Errorf("Expected an opaque token to be validated by the fallback, got: %v", err)
+	}
+
+	expired := validClaims()
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	if _, err := verifier.Verify(context.Background(), sign(t, "RS256", "key-1", key, expired)); err == nil {
+		t.Error("Expected an expired JWT to be rejected without calling the fallback")
+	}
+
+	// Expired keys are still used while the JWKS endpoint is down.
+	stub.Close()
+	verifier.jwks.expires = time.Now().Add(-time.Second)
+	claims, err = verifier.Verify(context.Background(), sign(t, "RS256", "key-1", key, validClaims()))
+	if err != nil || claims.Remote {
+		t.Errorf("Expected the cached keys to be used when the JWKS endpoint is down, got: %v", err)
+	}
+
+	// The fallback is used when no keys could ever be fetched.
+	verifier, _, unusedStub := initVerifier(t, fallback)
+	unusedStub.Close()
+	_, err = verifier.Verify(context.Background(), sign(t, "RS256", "key-1", key, validClaims()))
+	if err != nil || fallbackCalls != 2 {
+		t.Errorf("Expected the fallback to be called when the JWKS endpoint is down, got %v after %d calls", err, fallbackCalls)
+	}
+}
+
+func TestNewVerifierRequiresKeys(t *testing.T) {
+	_, err := NewVerifier(Config{})
+	if !errors.Is(err, lrerror.ErrInitialization) {
+		t.Errorf("Expected an initialization error, got: %v", err)
+	}
+}
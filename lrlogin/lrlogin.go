@@ -0,0 +1,335 @@
+// Package lrlogin orchestrates logins on Multi-factor Authentication enabled sites, where
+// the user may have to complete or enroll a second factor after entering their password.
+//
+// A Flow models the login as explicit states. Each call returns a Step holding the state
+// of the login and the actions the user can take next:
+//
+//	flow := lrlogin.Flow{Client: lrclient}
+//	step, err := flow.Login(ctx, lrlogin.Credentials{Email: email, Password: password})
+//	if err != nil {
+//		// handle error
+//	}
+//	if step.Allows(lrlogin.ActionVerifyGoogleAuthenticator) {
+//		// ask the user for the code of their authenticator app
+//		step, err = flow.VerifyGoogleAuthenticator(ctx, step, code)
+//	}
+//	switch step.State {
+//	case lrlogin.StateDone:
+//		// the user is logged in with step.Session
+//	case lrlogin.StateLocked:
+//		// tell the user their account is locked
+//	}
+//
+// Steps can be encoded as JSON, so that web applications can keep them between requests.
+package lrlogin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	lr "github.com/LoginRadius/go-sdk"
+	"github.com/LoginRadius/go-sdk/api/mfa"
+	"github.com/LoginRadius/go-sdk/httprutils"
+	"github.com/LoginRadius/go-sdk/lrbody"
+	"github.com/LoginRadius/go-sdk/lrerror"
+	"github.com/LoginRadius/go-sdk/lrjson"
+)
+
+// State is the state of a login.
+type State int
+
+const (
+	// StateCredentials is the state of a login awaiting the credentials of the user. It
+	// is the state of the zero Step.
+	StateCredentials State = iota
+	// StateSecondFactorRequired is the state of a login awaiting the second factor the
+	// user has configured.
+	StateSecondFactorRequired
+	// StateEnrollmentRequired is the state of a login awaiting a user without second
+	// factor to configure one.
+	StateEnrollmentRequired
+	// StateDone is the state of a completed login.
+	StateDone
+	// StateLocked is the state of a login rejected because the account is locked, e.g.
+	// after too many failed attempts.
+	StateLocked
+)
+
+// String returns the name of the state.
+func (s State) String() string {
+	switch s {
+	case StateCredentials:
+		return "credentials"
+	case StateSecondFactorRequired:
+		return "second factor required"
+	case StateEnrollmentRequired:
+		return "enrollment required"
+	case StateDone:
+		return "done"
+	case StateLocked:
+		return "locked"
+	default:
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+}
+
+// Action is an action the user can take to move a login forward.
+type Action int
+
+const (
+	// ActionLogin is Flow.Login.
+	ActionLogin Action = iota
+	// ActionVerifyGoogleAuthenticator is Flow.VerifyGoogleAuthenticator.
+	ActionVerifyGoogleAuthenticator
+	// ActionVerifyOTP is Flow.VerifyOTP.
+	ActionVerifyOTP
+	// ActionVerifyBackupCode is Flow.VerifyBackupCode.
+	ActionVerifyBackupCode
+	// ActionSetPhone is Flow.SetPhone.
+	ActionSetPhone
+)
+
+// String returns the name of the action.
+func (a Action) String() string {
+	switch a {
+	case ActionLogin:
+		return "login"
+	case ActionVerifyGoogleAuthenticator:
+		return "verify Google Authenticator code"
+	case ActionVerifyOTP:
+		return "verify OTP"
+	case ActionVerifyBackupCode:
+		return "verify backup code"
+	case ActionSetPhone:
+		return "set phone number"
+	default:
+		return fmt.Sprintf("Action(%d)", int(a))
+	}
+}
+
+// Credentials are the credentials of a user logging in. Exactly one of Email, Username
+// and Phone must be set.
+type Credentials struct {
+	Email    string
+	Username string
+	Phone    string
+	Password string
+}
+
+// Step is the state of a login after a call to a Flow, with the instructions for the
+// next step.
+type Step struct {
+	State State
+
+	// Next lists the actions the user can take next. It is empty once the login is done
+	// or locked.
+	Next []Action
+
+	// SecondFactorToken identifies the login while a second factor is required, until
+	// SecondFactorExpiry.
+	SecondFactorToken  string    `json:",omitempty"`
+	SecondFactorExpiry time.Time `json:",omitzero"`
+
+	// QRCode and ManualEntryCode let a user enrolling a second factor configure Google
+	// Authenticator, before entering a code with ActionVerifyGoogleAuthenticator.
+	QRCode          string `json:",omitempty"`
+	ManualEntryCode string `json:",omitempty"`
+
+	// OTPPhone is the phone number the OTP was sent to, for ActionVerifyOTP.
+	OTPPhone string `json:",omitempty"`
+
+	// Session is the session of the user once the login is done.
+	Session *lrjson.AccessToken `json:",omitempty"`
+}
+
+// Allows reports whether action is one of the next actions of the step.
+func (s *Step) Allows(action Action) bool {
+	if s == nil || s.State == StateCredentials {
+		return action == ActionLogin
+	}
+	return slices.Contains(s.Next, action)
+}
+
+// Flow runs logins on a Multi-factor Authentication enabled site. It holds no state and
+// can be used for any number of concurrent logins.
+type Flow struct {
+	Client *lr.Loginradius
+
+	// SMSTemplate2FA, when set, is the name of the SMS template used to send OTPs.
+	SMSTemplate2FA string
+}
+
+// Login logs the user in with its credentials, using PostMFAEmailLogin,
+// PostMFAUsernameLogin or PostMFAPhoneLogin depending on the identifier set.
+// Invalid credentials are returned as errors, the login staying in StateCredentials.
+func (f Flow) Login(ctx context.Context, credentials Credentials) (*Step, error) {
+	var set int
+	for _, identifier := range []string{credentials.Email, credentials.Username, credentials.Phone} {
+		if identifier != "" {
+			set++
+		}
+	}
+	if set != 1 || credentials.Password == "" {
+		errMsg := "Must log in with a password and exactly one of email, username and phone"
+		return nil, lrerror.New(lrerror.CodeValidation, errMsg, errors.New(errMsg))
+	}
+
+	api := mfa.Loginradius{Client: f.Client}
+	queries := f.queries("")
+	var res *httprutils.Response
+	var err error
+	switch {
+	case credentials.Email != "":
+		res, err = api.PostMFAEmailLoginWithContext(ctx, lrbody.EmailLogin{Email: credentials.Email, Password: credentials.Password}, queries)
+	case credentials.Username != "":
+		res, err = api.PostMFAUsernameLoginWithContext(ctx, lrbody.UsernameLogin{Username: credentials.Username, Password: credentials.Password}, queries)
+	default:
+		res, err = api.PostMFAPhoneLoginWithContext(ctx, map[string]string{"phone": credentials.Phone, "password": credentials.Password}, queries)
+	}
+	return next(res, err)
+}
+
+// VerifyGoogleAuthenticator completes the second factor with a Google Authenticator code,
+// enrolling Google Authenticator if the login is in StateEnrollmentRequired. An invalid
+// code is returned as an error, step remaining the current step.
+func (f Flow) VerifyGoogleAuthenticator(ctx context.Context, step *Step, code string) (*Step, error) {
+	if err := allow(step, ActionVerifyGoogleAuthenticator); err != nil {
+		return nil, err
+	}
+	res, err := mfa.Loginradius{Client: f.Client}.PutMFAValidateGoogleAuthCodeWithContext(ctx,
+		f.queries(step.SecondFactorToken), map[string]string{"googleauthenticatorcode": code})
+	return next(res, err)
+}
+
+// VerifyOTP completes the second factor with the OTP sent by SMS to step.OTPPhone. An
+// invalid OTP is returned as an error, step remaining the current step.
+func (f Flow) VerifyOTP(ctx context.Context, step *Step, otp string) (*Step, error) {
+	if err := allow(step, ActionVerifyOTP); err != nil {
+		return nil, err
+	}
+	res, err := mfa.Loginradius{Client: f.Client}.PutMFAValidateOTPWithContext(ctx,
+		f.queries(step.SecondFactorToken), map[string]string{"otp": otp})
+	return next(res, err)
+}
+
+// VerifyBackupCode completes the second factor with one of the backup codes of the user.
+// An invalid code is returned as an error, step remaining the current step.
+func (f Flow) VerifyBackupCode(ctx context.Context, step *Step, code string) (*Step, error) {
+	if err := allow(step, ActionVerifyBackupCode); err != nil {
+		return nil, err
+	}
+	res, err := mfa.Loginradius{Client: f.Client}.PutMFAValidateBackupCodeWithContext(ctx,
+		map[string]string{"secondfactorauthenticationtoken": step.SecondFactorToken}, map[string]string{"backupcode": code})
+	return next(res, err)
+}
+
+// SetPhone sets the phone number of a user enrolling the SMS second factor, or changes the
+// phone number the OTP is sent to, with PutMFAUpdatePhoneNumber. The returned step is in
+// the same state, awaiting the OTP sent to phone.
+func (f Flow) SetPhone(ctx context.Context, step *Step, phone string) (*Step, error) {
+	if err := allow(step, ActionSetPhone); err != nil {
+		return nil, err
+	}
+	_, err := mfa.Loginradius{Client: f.Client}.PutMFAUpdatePhoneNumberWithContext(ctx,
+		f.queries(step.SecondFactorToken), map[string]string{"phoneno2fa": phone})
+	if err != nil {
+		return locked(err)
+	}
+	updated := *step
+	updated.OTPPhone = phone
+	updated.Next = slices.Clone(step.Next)
+	if !updated.Allows(ActionVerifyOTP) {
+		updated.Next = append(updated.Next, ActionVerifyOTP)
+	}
+	return &updated, nil
+}
+
+// queries returns the query parameters of the MFA APIs for the second factor token, if any.
+func (f Flow) queries(secondFactorToken string) map[string]string {
+	queries := map[string]string{}
+	if secondFactorToken != "" {
+		queries["secondfactorauthenticationtoken"] = secondFactorToken
+	}
+	if f.SMSTemplate2FA != "" {
+		queries["smstemplate2fa"] = f.SMSTemplate2FA
+	}
+	return queries
+}
+
+// allow returns an error if action is not a next action of step.
+func allow(step *Step, action Action) error {
+	if step.Allows(action) {
+		return nil
+	}
+	state := StateCredentials
+	if step != nil {
+		state = step.State
+	}
+	errMsg := fmt.Sprintf("Cannot %s in login state %s", action, state)
+	return lrerror.New(lrerror.CodeValidation, errMsg, errors.New(errMsg))
+}
+
+// next returns the step following the response of a login or second factor API.
+func next(res *httprutils.Response, err error) (*Step, error) {
+	if err != nil {
+		return locked(err)
+	}
+	login, err := lrjson.DecodeAs[lrjson.MFALogin](res)
+	if err != nil {
+		return nil, err
+	}
+
+	factor := login.SecondFactorAuthentication
+	if factor == nil || factor.SecondFactorAuthenticationToken == "" {
+		if login.AccessToken.AccessToken == "" {
+			errMsg := "LoginRadius returned neither an access token nor a second factor"
+			return nil, lrerror.New(lrerror.CodeEncoding, "Error decoding the login response", errors.New(errMsg))
+		}
+		session := login.AccessToken
+		return &Step{State: StateDone, Session: &session}, nil
+	}
+
+	step := &Step{
+		SecondFactorToken:  factor.SecondFactorAuthenticationToken,
+		SecondFactorExpiry: factor.ExpireIn,
+		OTPPhone:           factor.OTPPhoneNo,
+	}
+	// LoginRadius reports a configured authenticator app either as IsAuthenticatorVerified
+	// or, on older sites, as IsGoogleAuthenticatorVerified.
+	authenticator := factor.IsGoogleAuthenticatorVerified || factor.IsAuthenticatorVerified
+	if authenticator || factor.IsOTPAuthenticatorVerified || factor.IsEmailOtpAuthenticatorVerified {
+		step.State = StateSecondFactorRequired
+		if authenticator {
+			step.Next = append(step.Next, ActionVerifyGoogleAuthenticator)
+		}
+		if factor.IsOTPAuthenticatorVerified {
+			step.Next = append(step.Next, ActionVerifyOTP, ActionSetPhone)
+		}
+		// Users whose only factor is an email OTP, which the SDK has no API to verify,
+		// complete the login with a backup code rather than enrolling again.
+		step.Next = append(step.Next, ActionVerifyBackupCode)
+		return step, nil
+	}
+
+	step.State = StateEnrollmentRequired
+	if factor.QRCode != "" || factor.ManualEntryCode != "" {
+		step.QRCode, step.ManualEntryCode = factor.QRCode, factor.ManualEntryCode
+		step.Next = append(step.Next, ActionVerifyGoogleAuthenticator)
+	}
+	if factor.OTPPhoneNo != "" {
+		step.Next = append(step.Next, ActionVerifyOTP)
+	}
+	step.Next = append(step.Next, ActionSetPhone)
+	return step, nil
+}
+
+// locked returns a step in StateLocked if err reports a locked account, and err otherwise.
+func locked(err error) (*Step, error) {
+	if errors.Is(err, lrerror.ErrAccountLocked) {
+		return &Step{State: StateLocked}, nil
+	}
+	return nil, err
+}
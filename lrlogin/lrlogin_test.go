@@ -0,0 +1,209 @@
+package lrlogin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	lr "github.com/LoginRadius/go-sdk"
+	"github.com/LoginRadius/go-sdk/httprutils"
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+const (
+	sessionBody  = `{"access_token": "access", "refresh_token": "refresh", "Profile": {"Uid": "test-uid"}}`
+	verifiedBody = `{"SecondFactorAuthentication": {"SecondFactorAuthenticationToken": "2fa-token", "IsGoogleAuthenticatorVerified": true, "IsOTPAuthenticatorVerified": true, "OTPPhoneNo": "+1555****00"}}`
+	enrollBody   = `{"SecondFactorAuthentication": {"SecondFactorAuthenticationToken": "2fa-token", "QRCode": "qr", "ManualEntryCode": "manual"}}`
+	lockedBody   = `{"ErrorCode": 1048, "Message": "Account is locked"}`
+)
+
+// initLoginradiusStub serves the MFA login APIs. Logins succeed without second factor for
+// the user "done", require a second factor for "verified", an enrollment for "new", and
+// fail for the locked user "locked". Second factors are valid for the code "123456".
+func initLoginradiusStub(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		switch r.Method + " " + r.URL.Path {
+		case "POST /identity/v2/auth/login/2fa":
+			user := body["Email"] + body["username"] + body["phone"]
+			switch user {
+			case "done":
+				fmt.Fprint(w, sessionBody)
+			case "verified":
+				fmt.Fprint(w, verifiedBody)
+			case "new":
+				fmt.Fprint(w, enrollBody)
+			case "locked":
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, lockedBody)
+			default:
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `{"ErrorCode": 1200, "Message": "Invalid credentials"}`)
+			}
+		case "PUT /identity/v2/auth/login/2fa/verification/googleauthenticatorcode",
+			"PUT /identity/v2/auth/login/2fa/verification/otp",
+			"PUT /identity/v2/auth/login/2fa/verification/backupcode":
+			if r.URL.Query().Get("secondfactorauthenticationtoken") != "2fa-token" {
+				t.Errorf("Expected the second factor token to be passed, got %q", r.URL.RawQuery)
+			}
+			code := body["googleauthenticatorcode"] + body["otp"] + body["backupcode"]
+			switch code {
+			case "123456":
+				fmt.Fprint(w, sessionBody)
+			case "locked":
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, lockedBody)
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"ErrorCode": 1066, "Message": "Invalid code"}`)
+			}
+		case "PUT /identity/v2/auth/login/2fa":
+			fmt.Fprint(w, `{"Data": {"AccountSid": "sid", "Sid": "sid"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func initFlow(stub *httptest.Server) Flow {
+	lrclient, _ := lr.NewLoginradius(&lr.Config{ApiKey: "abcd1234", ApiSecret: "abcd1234"})
+	lrclient.Domain = stub.URL
+	return Flow{Client: lrclient}
+}
+
+func TestLoginWithoutSecondFactor(t *testing.T) {
+	stub := initLoginradiusStub(t)
+	defer stub.Close()
+	flow := initFlow(stub)
+
+	for _, credentials := range []Credentials{
+		{Email: "done", Password: "password"},
+		{Username: "done", Password: "password"},
+		{Phone: "done", Password: "password"},
+	} {
+		step, err := flow.Login(context.Background(), credentials)
+		if err != nil || step.State != StateDone || step.Session.AccessToken != "access" || len(step.Next) != 0 {
+			t.Errorf("Expected the login with %+v to be done, got %+v, %v", credentials, step, err)
+		}
+	}
+}
+
+func TestLoginSecondFactorRequired(t *testing.T) {
+	stub := initLoginradiusStub(t)
+	defer stub.Close()
+	flow := initFlow(stub)
+	ctx := context.Background()
+
+	step, err := flow.Login(ctx, Credentials{Email: "verified", Password: "password"})
+	if err != nil || step.State != StateSecondFactorRequired || step.SecondFactorToken != "2fa-token" || step.OTPPhone != "+1555****00" {
+		t.Fatalf("Expected a second factor to be required, got %+v, %v", step, err)
+	}
+	want := []Action{ActionVerifyGoogleAuthenticator, ActionVerifyOTP, ActionSetPhone, ActionVerifyBackupCode}
+	if !slices.Equal(step.Next, want) {
+		t.Errorf("Expected next actions %v, got %v", want, step.Next)
+	}
+
+	// Steps survive being kept between requests.
+	data, _ := json.Marshal(step)
+	var kept Step
+	json.Unmarshal(data, &kept)
+
+	if _, err := flow.VerifyOTP(ctx, &kept, "000000"); err == nil {
+		t.Error("Expected an invalid OTP to be rejected")
+	}
+	for _, verify := range []func(context.Context, *Step, string) (*Step, error){flow.VerifyGoogleAuthenticator, flow.VerifyOTP, flow.VerifyBackupCode} {
+		done, err := verify(ctx, &kept, "123456")
+		if err != nil || done.State != StateDone || done.Session.Profile.UID != "test-uid" {
+			t.Errorf("Expected the login to be done, got %+v, %v", done, err)
+		}
+	}
+}
+
+func TestLoginEnrollmentRequired(t *testing.T) {
+	stub := initLoginradiusStub(t)
+	defer stub.Close()
+	flow := initFlow(stub)
+	ctx := context.Background()
+
+	step, err := flow.Login(ctx, Credentials{Username: "new", Password: "password"})
+	if err != nil || step.State != StateEnrollmentRequired || step.QRCode != "qr" || step.ManualEntryCode != "manual" {
+		t.Fatalf("Expected an enrollment to be required, got %+v, %v", step, err)
+	}
+	if !slices.Equal(step.Next, []Action{ActionVerifyGoogleAuthenticator, ActionSetPhone}) {
+		t.Errorf("Expected Google Authenticator or phone enrollment, got %v", step.Next)
+	}
+	if _, err := flow.VerifyOTP(ctx, step, "123456"); !errors.Is(err, lrerror.ErrValidation) {
+		t.Errorf("Expected OTPs to be rejected before a phone is set, got: %v", err)
+	}
+
+	withPhone, err := flow.SetPhone(ctx, step, "+15550000000")
+	if err != nil || withPhone.State != StateEnrollmentRequired || withPhone.OTPPhone != "+15550000000" || !withPhone.Allows(ActionVerifyOTP) {
+		t.Fatalf("Expected the OTP to be awaited, got %+v, %v", withPhone, err)
+	}
+	if step.Allows(ActionVerifyOTP) {
+		t.Error("Expected SetPhone not to modify the current step")
+	}
+	if done, err := flow.VerifyOTP(ctx, withPhone, "123456"); err != nil || done.State != StateDone {
+		t.Errorf("Expected the login to be done, got %+v, %v", done, err)
+	}
+}
+
+func TestLoginLocked(t *testing.T) {
+	stub := initLoginradiusStub(t)
+	defer stub.Close()
+	flow := initFlow(stub)
+	ctx := context.Background()
+
+	if step, err := flow.Login(ctx, Credentials{Phone: "locked", Password: "password"}); err != nil || step.State != StateLocked {
+		t.Errorf("Expected the login to be locked, got %+v, %v", step, err)
+	}
+	step, _ := flow.Login(ctx, Credentials{Email: "verified", Password: "password"})
+	if locked, err := flow.VerifyBackupCode(ctx, step, "locked"); err != nil || locked.State != StateLocked || len(locked.Next) != 0 {
+		t.Errorf("Expected the login to be locked, got %+v, %v", locked, err)
+	}
+}
+
+func TestLoginValidation(t *testing.T) {
+	flow := Flow{}
+	ctx := context.Background()
+	for _, credentials := range []Credentials{
+		{Email: "done"},
+		{Password: "password"},
+		{Email: "done", Username: "done", Password: "password"},
+	} {
+		if _, err := flow.Login(ctx, credentials); !errors.Is(err, lrerror.ErrValidation) {
+			t.Errorf("Expected the credentials %+v to be rejected, got: %v", credentials, err)
+		}
+	}
+	if _, err := flow.VerifyGoogleAuthenticator(ctx, &Step{}, "123456"); !errors.Is(err, lrerror.ErrValidation) {
+		t.Errorf("Expected a code to be rejected before login, got: %v", err)
+	}
+	if _, err := flow.VerifyBackupCode(ctx, &Step{State: StateDone}, "123456"); !errors.Is(err, lrerror.ErrValidation) {
+		t.Errorf("Expected a code to be rejected after login, got: %v", err)
+	}
+}
+
+func loginResponse(factor string) *httprutils.Response {
+	body := `{"SecondFactorAuthentication": {"SecondFactorAuthenticationToken": "2fa-token", ` + factor + `: true}}`
+	return &httprutils.Response{StatusCode: http.StatusOK, Body: body, OrigBody: []byte(body)}
+}
+
+func TestAuthenticatorVerified(t *testing.T) {
+	step, err := next(loginResponse(`"IsAuthenticatorVerified"`), nil)
+	if err != nil || step.State != StateSecondFactorRequired || !step.Allows(ActionVerifyGoogleAuthenticator) || step.Allows(ActionVerifyOTP) {
+		t.Errorf("Expected the authenticator app to be required, got %+v, %v", step, err)
+	}
+}
+
+func TestEmailOTPAuthenticatorVerified(t *testing.T) {
+	step, err := next(loginResponse(`"IsEmailOtpAuthenticatorVerified"`), nil)
+	if err != nil || step.State != StateSecondFactorRequired || !step.Allows(ActionVerifyBackupCode) || step.Allows(ActionSetPhone) {
+		t.Errorf("Expected a user with an email OTP factor not to enroll again, got %+v, %v", step, err)
+	}
+}
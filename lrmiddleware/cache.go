@@ -0,0 +1,73 @@
+package lrmiddleware
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/lrjson"
+)
+
+// Keys of the identities and role list in the cache backend.
+const (
+	identityKeyPrefix = "lrmiddleware:identity:"
+	rolesKey          = "lrmiddleware:roles"
+)
+
+// cachedIdentity is an identity as stored in the cache backend. The token is not stored,
+// so that the backend holds no access tokens.
+type cachedIdentity struct {
+	Profile     *lrjson.Profile
+	Roles       []string
+	Permissions map[string]bool
+	Expires     time.Time
+}
+
+// cachedRoles is the role list of the site, mapping roles to their permissions, as stored
+// in the cache backend.
+type cachedRoles struct {
+	Roles   map[string]map[string]bool
+	Expires time.Time
+}
+
+// lookupIdentity returns the cached identity of token.
+func (a *Authenticator) lookupIdentity(ctx context.Context, token string) (*Identity, bool) {
+	var cached cachedIdentity
+	if !a.cacheGet(ctx, identityKeyPrefix+hashToken(token), &cached, &cached.Expires) {
+		return nil, false
+	}
+	return &Identity{Token: token, Profile: cached.Profile, Roles: cached.Roles, Permissions: cached.Permissions}, true
+}
+
+func (a *Authenticator) cacheIdentity(ctx context.Context, identity *Identity) {
+	a.cacheSet(ctx, identityKeyPrefix+hashToken(identity.Token), cachedIdentity{
+		Profile:     identity.Profile,
+		Roles:       identity.Roles,
+		Permissions: identity.Permissions,
+		Expires:     a.now().Add(a.cfg.CacheTTL),
+	})
+}
+
+// cacheGet decodes the value stored under key into value, reporting whether it was found
+// and has not expired. Backend errors are treated as cache misses.
+func (a *Authenticator) cacheGet(ctx context.Context, key string, value interface{}, expires *time.Time) bool {
+	data, ok, err := a.cache.Get(ctx, key)
+	if err != nil || !ok {
+		return false
+	}
+	if err := json.Unmarshal(data, value); err != nil || !a.now().Before(*expires) {
+		a.cache.Delete(ctx, key)
+		return false
+	}
+	return true
+}
+
+// cacheSet stores value under key for CacheTTL. Backend errors are ignored, the value
+// being fetched again on the next call.
+func (a *Authenticator) cacheSet(ctx context.Context, key string, value interface{}) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	a.cache.Set(ctx, key, data, a.cfg.CacheTTL)
+}
@@ -0,0 +1,421 @@
+// Package lrmiddleware contains net/http middleware authenticating requests with LoginRadius
+// access tokens.
+//
+// The Authenticator middleware reads the access token of each request, validates it by
+// reading the user's profile and roles from LoginRadius, and stores the resulting Identity
+// in the request context, where handlers retrieve it with FromContext:
+//
+//	auth, err := lrmiddleware.New(lrmiddleware.Config{Client: lrclient, CacheTTL: time.Minute})
+//	if err != nil {
+//		// handle error
+//	}
+//	mux.Handle("/account", auth.Handler(accountHandler))
+//	mux.Handle("/admin", auth.Handler(lrmiddleware.RequireRoles("admin")(adminHandler)))
+package lrmiddleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	lr "github.com/LoginRadius/go-sdk"
+	lrauthentication "github.com/LoginRadius/go-sdk/api/authentication"
+	"github.com/LoginRadius/go-sdk/api/role"
+	"github.com/LoginRadius/go-sdk/lrcache"
+	"github.com/LoginRadius/go-sdk/lrerror"
+	"github.com/LoginRadius/go-sdk/lrjson"
+)
+
+// DefaultCacheSize is the number of identities cached when Config.CacheSize is not set.
+const DefaultCacheSize = 10000
+
+// DefaultRoleListTTL is how long the role list of the site is cached when Config.CacheTTL
+// is 0. The role list only maps roles to their permissions, so caching it does not delay
+// the rejection of invalidated tokens.
+const DefaultRoleListTTL = time.Minute
+
+// Identity is the authenticated user of a request.
+type Identity struct {
+	Token       string
+	Profile     *lrjson.Profile
+	Roles       []string
+	Permissions map[string]bool
+}
+
+// HasRole reports whether the user has the given role.
+func (i *Identity) HasRole(role string) bool {
+	for _, r := range i.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPermission reports whether one of the user's roles grants the given permission.
+func (i *Identity) HasPermission(permission string) bool {
+	return i.Permissions[permission]
+}
+
+// A TokenExtractor reads the access token of a request, returning "" if there is none.
+type TokenExtractor func(r *http.Request) string
+
+// FromAuthorizationHeader reads the token from an "Authorization: Bearer" header.
+func FromAuthorizationHeader() TokenExtractor {
+	return func(r *http.Request) string {
+		header := r.Header.Get("Authorization")
+		if len(header) > 7 && strings.EqualFold(header[:7], "Bearer ") {
+			return strings.TrimSpace(header[7:])
+		}
+		return ""
+	}
+}
+
+// FromHeader reads the token from the given header.
+func FromHeader(name string) TokenExtractor {
+	return func(r *http.Request) string {
+		return r.Header.Get(name)
+	}
+}
+
+// FromCookie reads the token from the given cookie.
+func FromCookie(name string) TokenExtractor {
+	return func(r *http.Request) string {
+		cookie, err := r.Cookie(name)
+		if err != nil {
+			return ""
+		}
+		return cookie.Value
+	}
+}
+
+// Config holds the settings of an Authenticator.
+type Config struct {
+	// Client is the LoginRadius client used to validate tokens. It does not need to be
+	// initialized with an access token.
+	Client *lr.Loginradius
+
+	// TokenExtractors are tried in order until one returns a token.
+	// The Authorization header is used by default.
+	TokenExtractors []TokenExtractor
+
+	// CacheTTL is how long the identity of a token and the role list are cached. Identities
+	// are not cached when CacheTTL is 0, and every request is validated with LoginRadius,
+	// while the role list is cached in memory for DefaultRoleListTTL.
+	CacheTTL time.Duration
+
+	// CacheSize bounds the number of cached identities, DefaultCacheSize by default.
+	CacheSize int
+
+	// Cache, when set, stores the cached identities and role list, e.g. to share them
+	// across the replicas of a service. An lrcache.LRU of CacheSize entries by default.
+	Cache lrcache.Cache
+
+	// ErrorHandler writes the response of rejected requests. status is
+	// http.StatusUnauthorized for missing, invalid or expired tokens, and
+	// http.StatusBadGateway when LoginRadius could not be reached or failed the call
+	// otherwise, e.g. rate limiting it. By default it writes the status text.
+	ErrorHandler func(w http.ResponseWriter, r *http.Request, err error, status int)
+}
+
+// An Authenticator authenticates requests with LoginRadius. It is safe for concurrent use.
+type Authenticator struct {
+	cfg   Config
+	cache lrcache.Cache
+	now   func() time.Time
+
+	// rolesMu guards roles, the role list cached when identities are not, and rolesFetch,
+	// the fetch of the role list in flight shared by the requests needing it meanwhile.
+	rolesMu    sync.Mutex
+	roles      *cachedRoles
+	rolesFetch *rolesFetch
+}
+
+// rolesFetch is a fetch of the role list in flight.
+type rolesFetch struct {
+	done      chan struct{}
+	roles     map[string]map[string]bool
+	err       error
+	cancelled bool // the fetch failed because the context of its caller ended
+}
+
+// New returns an Authenticator configured with cfg.
+func New(cfg Config) (*Authenticator, error) {
+	if cfg.Client == nil {
+		errMsg := "Must initialize the authentication middleware with a Loginradius client"
+		return nil, lrerror.New(lrerror.CodeInitialization, errMsg, errors.New(errMsg))
+	}
+	if len(cfg.TokenExtractors) == 0 {
+		cfg.TokenExtractors = []TokenExtractor{FromAuthorizationHeader()}
+	}
+	if cfg.CacheSize <= 0 {
+		cfg.CacheSize = DefaultCacheSize
+	}
+	if cfg.ErrorHandler == nil {
+		cfg.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error, status int) {
+			http.Error(w, http.StatusText(status), status)
+		}
+	}
+
+	a := &Authenticator{cfg: cfg, now: time.Now}
+	if cfg.CacheTTL > 0 {
+		a.cache = cfg.Cache
+		if a.cache == nil {
+			a.cache = lrcache.NewLRU(cfg.CacheSize)
+		}
+	}
+	return a, nil
+}
+
+// Handler returns a handler authenticating requests before passing them to next.
+// Requests without a valid token are rejected.
+func (a *Authenticator) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := a.extractToken(r)
+		if token == "" {
+			errMsg := "Request has no access token"
+			a.cfg.ErrorHandler(w, r, lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg)), http.StatusUnauthorized)
+			return
+		}
+
+		identity, err := a.Authenticate(r.Context(), token)
+		if err != nil {
+			status := http.StatusBadGateway
+			var apiErr *lrerror.APIError
+			if errors.Is(err, lrerror.ErrInvalidToken) || errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized {
+				status = http.StatusUnauthorized
+			}
+			a.cfg.ErrorHandler(w, r, err, status)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), identity)))
+	})
+}
+
+// Authenticate validates token and returns the identity of its user.
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (*Identity, error) {
+	if a.cache != nil {
+		if identity, ok := a.lookupIdentity(ctx, token); ok {
+			return identity, nil
+		}
+	}
+
+	res, err := lrauthentication.Loginradius{Client: a.cfg.Client.WithToken(token)}.GetAuthReadProfilesByTokenWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := lrjson.DecodeAs[lrjson.Profile](res)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = role.Loginradius{Client: a.cfg.Client}.GetRolesByUIDWithContext(ctx, profile.UID)
+	if err != nil {
+		return nil, err
+	}
+	userRoles, err := lrjson.DecodeAs[lrjson.UserRoles](res)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := a.permissions(ctx, userRoles.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := &Identity{
+		Token:       token,
+		Profile:     &profile,
+		Roles:       userRoles.Roles,
+		Permissions: permissions,
+	}
+	if a.cache != nil {
+		a.cacheIdentity(ctx, identity)
+	}
+	return identity, nil
+}
+
+// Invalidate removes the cached identity of token, e.g. after the user logs out.
+func (a *Authenticator) Invalidate(token string) {
+	if a.cache != nil {
+		a.cache.Delete(context.Background(), identityKeyPrefix+hashToken(token))
+	}
+}
+
+// permissions returns the permissions granted by the given roles. The role list of the
+// site is cached for CacheTTL, or DefaultRoleListTTL if CacheTTL is 0.
+func (a *Authenticator) permissions(ctx context.Context, roles []string) (map[string]bool, error) {
+	permissions := map[string]bool{}
+	if len(roles) == 0 {
+		return permissions, nil
+	}
+
+	siteRoles, err := a.roleList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range roles {
+		for permission, granted := range siteRoles[r] {
+			if granted {
+				permissions[permission] = true
+			}
+		}
+	}
+	return permissions, nil
+}
+
+// roleList returns the role list of the site, mapping roles to their permissions. It is
+// fetched by a single request at a time, whose result the others share.
+func (a *Authenticator) roleList(ctx context.Context) (map[string]map[string]bool, error) {
+	for {
+		if roles, ok := a.cachedRoleList(ctx); ok {
+			return roles, nil
+		}
+
+		a.rolesMu.Lock()
+		if f := a.rolesFetch; f != nil {
+			a.rolesMu.Unlock()
+			select {
+			case <-f.done:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			if f.cancelled {
+				continue
+			}
+			return f.roles, f.err
+		}
+		f := &rolesFetch{done: make(chan struct{})}
+		a.rolesFetch = f
+		a.rolesMu.Unlock()
+
+		f.roles, f.err = a.fetchRoleList(ctx)
+		f.cancelled = f.err != nil && ctx.Err() != nil
+		a.rolesMu.Lock()
+		a.rolesFetch = nil
+		a.rolesMu.Unlock()
+		close(f.done)
+		return f.roles, f.err
+	}
+}
+
+// cachedRoleList returns the cached role list, from the cache if identities are cached.
+func (a *Authenticator) cachedRoleList(ctx context.Context) (map[string]map[string]bool, bool) {
+	if a.cache != nil {
+		var cached cachedRoles
+		if a.cacheGet(ctx, rolesKey, &cached, &cached.Expires) {
+			return cached.Roles, true
+		}
+		return nil, false
+	}
+
+	a.rolesMu.Lock()
+	defer a.rolesMu.Unlock()
+	if a.roles != nil && a.now().Before(a.roles.Expires) {
+		return a.roles.Roles, true
+	}
+	return nil, false
+}
+
+// fetchRoleList fetches the role list and caches it.
+func (a *Authenticator) fetchRoleList(ctx context.Context) (map[string]map[string]bool, error) {
+	res, err := role.Loginradius{Client: a.cfg.Client}.GetRolesListWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := lrjson.DecodeAs[lrjson.RoleList](res)
+	if err != nil {
+		return nil, err
+	}
+	cached := cachedRoles{Roles: map[string]map[string]bool{}}
+	for _, r := range list.Data {
+		cached.Roles[r.Name] = r.Permissions
+	}
+
+	if a.cache != nil {
+		cached.Expires = a.now().Add(a.cfg.CacheTTL)
+		a.cacheSet(ctx, rolesKey, cached)
+	} else {
+		cached.Expires = a.now().Add(DefaultRoleListTTL)
+		a.rolesMu.Lock()
+		a.roles = &cached
+		a.rolesMu.Unlock()
+	}
+	return cached.Roles, nil
+}
+
+func (a *Authenticator) extractToken(r *http.Request) string {
+	for _, extract := range a.cfg.TokenExtractors {
+		if token := extract(r); token != "" {
+			return token
+		}
+	}
+	return ""
+}
+
+// RequireRoles returns middleware rejecting requests whose user has none of the given roles.
+// It must be wrapped by an Authenticator's Handler.
+func RequireRoles(roles ...string) func(http.Handler) http.Handler {
+	return require(func(identity *Identity) bool {
+		for _, r := range roles {
+			if identity.HasRole(r) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// RequirePermissions returns middleware rejecting requests whose user lacks any of the
+// given permissions. It must be wrapped by an Authenticator's Handler.
+func RequirePermissions(permissions ...string) func(http.Handler) http.Handler {
+	return require(func(identity *Identity) bool {
+		for _, p := range permissions {
+			if !identity.HasPermission(p) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func require(allowed func(*Identity) bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			identity, ok := FromContext(r.Context())
+			if !ok {
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+			if !allowed(identity) {
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx holding identity.
+func NewContext(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, identity)
+}
+
+// FromContext returns the identity stored in ctx by the Authenticator.
+func FromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(contextKey{}).(*Identity)
+	return identity, ok
+}
+
+// hashToken returns the key the identity of a token is cached under.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,230 @@
+package lrmiddleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	lr "github.com/LoginRadius/go-sdk"
+	"github.com/LoginRadius/go-sdk/lrcache"
+)
+
+const validToken = "9c3208ae-2848-4ac5-baef-41dd4103e263"
+
+// initLoginradiusStub serves the profile, role and role list APIs for validToken.
+func initLoginradiusStub(profileCalls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/identity/v2/auth/account":
+			atomic.AddInt32(profileCalls, 1)
+			if r.Header.Get("Authorization") != "Bearer "+validToken {
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(w, `{"ErrorCode": 906, "Message": "Access token is invalid"}`)
+				return
+			}
+			fmt.Fprint(w, `{"Uid": "test-uid", "FirstName": "Test"}`)
+		case r.URL.Path == "/identity/v2/manage/account/test-uid/role":
+			fmt.Fprint(w, `{"Roles": ["editor"]}`)
+		case r.URL.Path == "/identity/v2/manage/role":
+			fmt.Fprint(w, `{"Data": [{"Name": "editor", "Permissions": {"posts:write": true}}, {"Name": "admin", "Permissions": {"users:delete": true}}], "Count": 2}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func initAuthenticator(t *testing.T, stub *httptest.Server, cfg Config) *Authenticator {
+	lrclient, _ := lr.NewLoginradius(&lr.Config{ApiKey: "abcd1234", ApiSecret: "abcd1234"})
+	lrclient.Domain = stub.URL
+	cfg.Client = lrclient
+	auth, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return auth
+}
+
+func serve(handler http.Handler, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+var identityHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	identity, ok := FromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	fmt.Fprint(w, identity.Profile.UID+" "+strings.Join(identity.Roles, ","))
+})
+
+func TestAuthenticatorHandler(t *testing.T) {
+	var profileCalls int32
+	stub := initLoginradiusStub(&profileCalls)
+	defer stub.Close()
+	auth := initAuthenticator(t, stub, Config{})
+
+	rec := serve(auth.Handler(identityHandler), validToken)
+	if rec.Code != http.StatusOK || rec.Body.String() != "test-uid editor" {
+		t.Errorf("Expected the identity in the request context, got %d %q", rec.Code, rec.Body.String())
+	}
+	if rec := serve(auth.Handler(identityHandler), ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected a request without token to be rejected, got %d", rec.Code)
+	}
+	if rec := serve(auth.Handler(identityHandler), "invalid"); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected a request with an invalid token to be rejected, got %d", rec.Code)
+	}
+}
+
+func TestAuthenticatorCache(t *testing.T) {
+	var profileCalls int32
+	stub := initLoginradiusStub(&profileCalls)
+	defer stub.Close()
+	auth := initAuthenticator(t, stub, Config{CacheTTL: time.Minute})
+
+	serve(auth.Handler(identityHandler), validToken)
+	serve(auth.Handler(identityHandler), validToken)
+	if profileCalls != 1 {
+		t.Errorf("Expected the identity to be cached, got %d profile calls", profileCalls)
+	}
+
+	auth.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	serve(auth.Handler(identityHandler), validToken)
+	if profileCalls != 2 {
+		t.Errorf("Expected the cached identity to expire, got %d profile calls", profileCalls)
+	}
+
+	auth.Invalidate(validToken)
+	serve(auth.Handler(identityHandler), validToken)
+	if profileCalls != 3 {
+		t.Errorf("Expected the invalidated identity to be fetched again, got %d profile calls", profileCalls)
+	}
+}
+
+func TestAuthenticatorSharedCache(t *testing.T) {
+	var profileCalls int32
+	stub := initLoginradiusStub(&profileCalls)
+	defer stub.Close()
+	cache := lrcache.NewLRU(100)
+	first := initAuthenticator(t, stub, Config{CacheTTL: time.Minute, Cache: cache})
+	second := initAuthenticator(t, stub, Config{CacheTTL: time.Minute, Cache: cache})
+
+	serve(first.Handler(identityHandler), validToken)
+	rec := serve(second.Handler(identityHandler), validToken)
+	if rec.Body.String() != "test-uid editor" || profileCalls != 1 {
+		t.Errorf("Expected the identity to be shared through the cache, got %q and %d profile calls", rec.Body.String(), profileCalls)
+	}
+	if cache.Len() != 2 {
+		t.Errorf("Expected the identity and the role list to be cached, got %d entries", cache.Len())
+	}
+
+	first.Invalidate(validToken)
+	serve(second.Handler(identityHandler), validToken)
+	if profileCalls != 2 {
+		t.Errorf("Expected the invalidated identity to be fetched again, got %d profile calls", profileCalls)
+	}
+}
+
+func TestAuthenticatorRoleListFetchedOnce(t *testing.T) {
+	var roleListCalls int32
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/identity/v2/auth/account":
+			fmt.Fprint(w, `{"Uid": "test-uid"}`)
+		case "/identity/v2/manage/account/test-uid/role":
+			fmt.Fprint(w, `{"Roles": ["editor"]}`)
+		case "/identity/v2/manage/role":
+			atomic.AddInt32(&roleListCalls, 1)
+			time.Sleep(50 * time.Millisecond)
+			fmt.Fprint(w, `{"Data": [{"Name": "editor", "Permissions": {"posts:write": true}}], "Count": 1}`)
+		}
+	}))
+	defer stub.Close()
+	auth := initAuthenticator(t, stub, Config{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if rec := serve(auth.Handler(RequirePermissions("posts:write")(identityHandler)), validToken); rec.Code != http.StatusOK {
+				t.Errorf("Expected the request to be authorized, got %d", rec.Code)
+			}
+		}()
+	}
+	wg.Wait()
+	serve(auth.Handler(identityHandler), validToken)
+	if roleListCalls != 1 {
+		t.Errorf("Expected the role list to be fetched once and cached, got %d calls", roleListCalls)
+	}
+}
+
+func TestAuthenticatorUpstreamErrors(t *testing.T) {
+	for _, status := range []int{http.StatusForbidden, http.StatusTooManyRequests, http.StatusInternalServerError} {
+		stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/identity/v2/auth/account" {
+				fmt.Fprint(w, `{"Uid": "test-uid"}`)
+				return
+			}
+			w.WriteHeader(status)
+			fmt.Fprint(w, `{"ErrorCode": 1000, "Message": "Request failed"}`)
+		}))
+		auth := initAuthenticator(t, stub, Config{})
+		if rec := serve(auth.Handler(identityHandler), validToken); rec.Code != http.StatusBadGateway {
+			t.Errorf("Expected a %d from the role APIs to fail with a bad gateway, got %d", status, rec.Code)
+		}
+		stub.Close()
+	}
+}
+
+func TestRequireRolesAndPermissions(t *testing.T) {
+	var profileCalls int32
+	stub := initLoginradiusStub(&profileCalls)
+	defer stub.Close()
+	auth := initAuthenticator(t, stub, Config{CacheTTL: time.Minute})
+
+	cases := []struct {
+		middleware func(http.Handler) http.Handler
+		status     int
+	}{
+		{RequireRoles("admin", "editor"), http.StatusOK},
+		{RequireRoles("admin"), http.StatusForbidden},
+		{RequirePermissions("posts:write"), http.StatusOK},
+		{RequirePermissions("posts:write", "users:delete"), http.StatusForbidden},
+	}
+	for i, c := range cases {
+		if rec := serve(auth.Handler(c.middleware(identityHandler)), validToken); rec.Code != c.status {
+			t.Errorf("Case %d: expected status %d, got %d", i, c.status, rec.Code)
+		}
+	}
+
+	if rec := serve(RequireRoles("editor")(identityHandler), validToken); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected a request not authenticated by the Authenticator to be rejected, got %d", rec.Code)
+	}
+}
+
+func TestTokenExtractors(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Access-Token", "from-header")
+	req.AddCookie(&http.Cookie{Name: "lr-token", Value: "from-cookie"})
+
+	if token := FromAuthorizationHeader()(req); token != "" {
+		t.Errorf("Expected no bearer token, got %q", token)
+	}
+	if token := FromHeader("X-Access-Token")(req); token != "from-header" {
+		t.Errorf("Expected the token from the header, got %q", token)
+	}
+	if token := FromCookie("lr-token")(req); token != "from-cookie" {
+		t.Errorf("Expected the token from the cookie, got %q", token)
+	}
+}
@@ -0,0 +1,497 @@
+// Package lroidc is an OpenID Connect relying party for the hosted login of LoginRadius,
+// implementing the OAuth 2.0 authorization code flow with PKCE.
+//
+// AuthCodeURL returns the URL to redirect the user to, along with the state, nonce and
+// PKCE code verifier to keep in the user's session until the callback. Exchange then
+// checks the callback against them, exchanges the code for tokens and validates the ID
+// token:
+//
+//	client, err := lroidc.New(lroidc.Config{
+//		Issuer:       "https://<site>.hub.loginradius.com/service/oidc/<app>",
+//		ClientID:     clientID,
+//		ClientSecret: clientSecret,
+//		RedirectURL:  "https://example.com/callback",
+//	})
+//
+//	// In the login handler:
+//	auth, err := client.AuthCodeURL(ctx)
+//	session.Set("oidc", auth)
+//	http.Redirect(w, r, auth.URL, http.StatusFound)
+//
+//	// In the callback handler:
+//	tokens, err := client.Exchange(ctx, session.Get("oidc"), r.URL.Query())
+//	profile := tokens.Profile()
+package lroidc
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/httprutils"
+	"github.com/LoginRadius/go-sdk/lrcache"
+	"github.com/LoginRadius/go-sdk/lrerror"
+	"github.com/LoginRadius/go-sdk/lrjson"
+	"github.com/LoginRadius/go-sdk/lrjwt"
+	"github.com/LoginRadius/go-sdk/lrtoken"
+)
+
+// CodeAuthorization is the code of the errors returned for callbacks reporting an error,
+// or not matching the authorization request.
+const CodeAuthorization = "AuthorizationError"
+
+// DefaultScopes are the scopes requested when Config.Scopes is not set.
+var DefaultScopes = []string{"openid", "email", "profile"}
+
+// Config holds the settings of a Client.
+type Config struct {
+	// Issuer is the issuer of the ID tokens. Endpoints not configured below are
+	// discovered from its /.well-known/openid-configuration document.
+	Issuer string
+
+	// ClientID and ClientSecret are the credentials of the OIDC application. The secret
+	// may be empty for public clients, which are authenticated by PKCE alone. ID tokens
+	// are verified with the keys of JWKSURL, and with the secret only if the discovery
+	// document of the Issuer lists HS256 in id_token_signing_alg_values_supported.
+	ClientID     string
+	ClientSecret string
+
+	// RedirectURL is the callback URL the user is redirected to after logging in.
+	RedirectURL string
+
+	// Scopes are the scopes requested, DefaultScopes by default.
+	Scopes []string
+
+	// The endpoints of the provider, discovered from the Issuer when not set.
+	AuthURL     string
+	TokenURL    string
+	UserInfoURL string
+	JWKSURL     string
+
+	// ClockSkew is the leeway allowed when checking the expiry of ID tokens.
+	ClockSkew time.Duration
+
+	// HTTPClient is used to call the provider, httprutils.TimeoutClient by default.
+	HTTPClient *httprutils.Client
+
+	// Cache, when set, stores the JWKS document of the provider, see lrjwt.Config.
+	Cache lrcache.Cache
+}
+
+// Client is an OpenID Connect relying party. It is safe for concurrent use.
+type Client struct {
+	cfg Config
+
+	mu          sync.Mutex
+	verifier    *lrjwt.Verifier
+	discovering *discovery
+}
+
+// discovery is a discovery of the provider in flight, shared by the calls made meanwhile.
+type discovery struct {
+	done      chan struct{}
+	err       error
+	cancelled bool // whether the discovery failed because its caller's context ended
+}
+
+// providerMetadata holds the fields of a discovery document used by the Client.
+type providerMetadata struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	UserInfoEndpoint      string   `json:"userinfo_endpoint"`
+	JWKSURI               string   `json:"jwks_uri"`
+	SigningAlgs           []string `json:"id_token_signing_alg_values_supported"`
+}
+
+// New returns a Client configured with cfg. The provider is only contacted on first use.
+func New(cfg Config) (*Client, error) {
+	if cfg.Issuer == "" || cfg.ClientID == "" || cfg.RedirectURL == "" {
+		errMsg := "Must configure the issuer, client id and redirect URL of the OIDC client"
+		return nil, lrerror.New(lrerror.CodeInitialization, errMsg, errors.New(errMsg))
+	}
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = DefaultScopes
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = httprutils.TimeoutClient
+	}
+	return &Client{cfg: cfg}, nil
+}
+
+// AuthRequest is an authorization request. State, Nonce and CodeVerifier must be kept,
+// typically in the user's session, until the callback is handled by Exchange.
+type AuthRequest struct {
+	// URL is the authorization URL to redirect the user to.
+	URL string
+
+	State        string
+	Nonce        string
+	CodeVerifier string
+}
+
+// AuthCodeURL returns a new authorization request, with random state, nonce and PKCE
+// code verifier. The code challenge is sent with the S256 method.
+func (c *Client) AuthCodeURL(ctx context.Context) (*AuthRequest, error) {
+	if _, err := c.provider(ctx); err != nil {
+		return nil, err
+	}
+	auth := &AuthRequest{State: randomString(), Nonce: randomString(), CodeVerifier: randomString()}
+	challenge := sha256.Sum256([]byte(auth.CodeVerifier))
+
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {c.cfg.ClientID},
+		"redirect_uri":          {c.cfg.RedirectURL},
+		"scope":                 {strings.Join(c.cfg.Scopes, " ")},
+		"state":                 {auth.State},
+		"nonce":                 {auth.Nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+	separator := "?"
+	if strings.Contains(c.cfg.AuthURL, "?") {
+		separator = "&"
+	}
+	auth.URL = c.cfg.AuthURL + separator + params.Encode()
+	return auth, nil
+}
+
+// Tokens are the tokens returned by the token endpoint.
+type Tokens struct {
+	AccessToken  string
+	TokenType    string
+	RefreshToken string
+	IDToken      string
+
+	// Expiry is when the access token expires. The zero value means it does not expire.
+	Expiry time.Time
+
+	// IDClaims are the claims of the validated ID token, nil if no ID token was returned.
+	IDClaims *lrjwt.Claims
+}
+
+// Profile returns the profile of the user held in the ID token claims, nil if no ID token
+// was returned.
+func (t *Tokens) Profile() *lrjson.Profile {
+	if t.IDClaims == nil {
+		return nil
+	}
+	return ProfileFromClaims(t.IDClaims.Raw)
+}
+
+// Token returns the tokens as an lrtoken.Token, e.g. to keep them in an lrtoken.Store.
+func (t *Tokens) Token() *lrtoken.Token {
+	return &lrtoken.Token{AccessToken: t.AccessToken, RefreshToken: t.RefreshToken, Expiry: t.Expiry}
+}
+
+// Exchange handles the callback of auth, whose query parameters are passed in callback:
+// it checks the state, exchanges the code for tokens with the PKCE code verifier and
+// validates the ID token, including its nonce. An ID token is required when the openid
+// scope is requested.
+//
+// Errors returned by the token endpoint are *lrerror.APIError holding the OAuth error in
+// their Body.
+func (c *Client) Exchange(ctx context.Context, auth *AuthRequest, callback url.Values) (*Tokens, error) {
+	if auth == nil || auth.State == "" {
+		errMsg := "No authorization request is pending"
+		return nil, lrerror.New(CodeAuthorization, errMsg, errors.New(errMsg))
+	}
+	if oauthErr := callback.Get("error"); oauthErr != "" {
+		return nil, lrerror.New(CodeAuthorization, "Authorization failed", errors.New(oauthErr+": "+callback.Get("error_description")))
+	}
+	if callback.Get("state") != auth.State {
+		errMsg := "Callback state does not match the authorization request"
+		return nil, lrerror.New(CodeAuthorization, errMsg, errors.New(errMsg))
+	}
+	code := callback.Get("code")
+	if code == "" {
+		errMsg := "Callback holds no authorization code"
+		return nil, lrerror.New(CodeAuthorization, errMsg, errors.New(errMsg))
+	}
+
+	tokens, err := c.token(ctx, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {c.cfg.RedirectURL},
+		"code_verifier": {auth.CodeVerifier},
+	}, "lroidc.Exchange")
+	if err != nil {
+		return nil, err
+	}
+	if tokens.IDClaims == nil {
+		if !slices.Contains(c.cfg.Scopes, "openid") {
+			return tokens, nil
+		}
+		errMsg := "Token endpoint returned no ID token"
+		return nil, lrerror.New(lrjwt.CodeInvalidToken, errMsg, errors.New(errMsg))
+	}
+	if nonce, _ := tokens.IDClaims.Raw["nonce"].(string); nonce != auth.Nonce {
+		errMsg := "ID token nonce does not match the authorization request"
+		return nil, lrerror.New(lrjwt.CodeInvalidToken, errMsg, errors.New(errMsg))
+	}
+	return tokens, nil
+}
+
+// Refresh exchanges refreshToken for new tokens. The ID token, if returned, is validated.
+// The refresh token is kept in the returned Tokens if the provider did not rotate it.
+func (c *Client) Refresh(ctx context.Context, refreshToken string) (*Tokens, error) {
+	tokens, err := c.token(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	}, "lroidc.Refresh")
+	if err != nil {
+		return nil, err
+	}
+	if tokens.RefreshToken == "" {
+		tokens.RefreshToken = refreshToken
+	}
+	return tokens, nil
+}
+
+// RefreshFunc returns an lrtoken.RefreshFunc refreshing tokens with Refresh, so that an
+// lrtoken.Source keeps the tokens of the user fresh.
+func (c *Client) RefreshFunc() lrtoken.RefreshFunc {
+	return func(ctx context.Context, token *lrtoken.Token) (*lrtoken.Token, error) {
+		tokens, err := c.Refresh(ctx, token.RefreshToken)
+		if err != nil {
+			return nil, err
+		}
+		return tokens.Token(), nil
+	}
+}
+
+// UserInfo fetches the profile of the user holding accessToken from the userinfo
+// endpoint.
+func (c *Client) UserInfo(ctx context.Context, accessToken string) (*lrjson.Profile, error) {
+	if _, err := c.provider(ctx); err != nil {
+		return nil, err
+	}
+	if c.cfg.UserInfoURL == "" {
+		errMsg := "OpenID configuration has no userinfo endpoint"
+		return nil, lrerror.New(lrerror.CodeInitialization, errMsg, errors.New(errMsg))
+	}
+	res, err := c.cfg.HTTPClient.SendWithContext(ctx, httprutils.Request{
+		Method:   httprutils.Get,
+		URL:      c.cfg.UserInfoURL,
+		Headers:  map[string]string{"Authorization": "Bearer " + accessToken, "Accept": "application/json"},
+		Endpoint: httprutils.Endpoint{Operation: "lroidc.UserInfo"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	claims, err := lrjson.DecodeAs[map[string]interface{}](res)
+	if err != nil {
+		return nil, err
+	}
+	return ProfileFromClaims(claims), nil
+}
+
+// token calls the token endpoint with params and validates the returned ID token.
+func (c *Client) token(ctx context.Context, params url.Values, operation string) (*Tokens, error) {
+	verifier, err := c.provider(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params.Set("client_id", c.cfg.ClientID)
+	if c.cfg.ClientSecret != "" {
+		params.Set("client_secret", c.cfg.ClientSecret)
+	}
+
+	res, err := c.cfg.HTTPClient.SendWithContext(ctx, httprutils.Request{
+		Method: httprutils.Post,
+		URL:    c.cfg.TokenURL,
+		Headers: map[string]string{
+			"Content-Type": "application/x-www-form-urlencoded",
+			"Accept":       "application/json",
+		},
+		Body:     bytes.NewBufferString(params.Encode()),
+		Endpoint: httprutils.Endpoint{Operation: operation},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var body struct {
+		AccessToken  string          `json:"access_token"`
+		TokenType    string          `json:"token_type"`
+		RefreshToken string          `json:"refresh_token"`
+		IDToken      string          `json:"id_token"`
+		ExpiresIn    json.RawMessage `json:"expires_in"`
+	}
+	if err := json.Unmarshal(res.OrigBody, &body); err != nil {
+		return nil, lrerror.New(lrerror.CodeEncoding, "Error decoding the token response", err)
+	}
+	if body.AccessToken == "" {
+		errMsg := "Token endpoint returned no access token"
+		return nil, lrerror.New(lrerror.CodeEncoding, "Error decoding the token response", errors.New(errMsg))
+	}
+
+	tokens := &Tokens{
+		AccessToken:  body.AccessToken,
+		TokenType:    body.TokenType,
+		RefreshToken: body.RefreshToken,
+		IDToken:      body.IDToken,
+		Expiry:       expiry(body.ExpiresIn),
+	}
+	if body.IDToken != "" {
+		if tokens.IDClaims, err = verifier.Verify(ctx, body.IDToken); err != nil {
+			return nil, err
+		}
+	}
+	return tokens, nil
+}
+
+// expiry returns the expiry of an access token from the expires_in field of a token
+// response, a number of seconds, or a timestamp in the responses of LoginRadius APIs.
+func expiry(expiresIn json.RawMessage) time.Time {
+	if seconds, err := strconv.ParseInt(strings.Trim(string(expiresIn), `"`), 10, 64); err == nil && seconds > 0 {
+		return time.Now().Add(time.Duration(seconds) * time.Second)
+	}
+	var at time.Time
+	if json.Unmarshal(expiresIn, &at) == nil {
+		return at
+	}
+	return time.Time{}
+}
+
+// provider discovers the endpoints of the provider not configured, and returns the
+// verifier of its ID tokens. Concurrent calls share a single discovery, made without
+// holding the lock. Failed discoveries are retried on the next call.
+func (c *Client) provider(ctx context.Context) (*lrjwt.Verifier, error) {
+	for {
+		c.mu.Lock()
+		if c.verifier != nil {
+			verifier := c.verifier
+			c.mu.Unlock()
+			return verifier, nil
+		}
+		d := c.discovering
+		leader := d == nil
+		if leader {
+			d = &discovery{done: make(chan struct{})}
+			c.discovering = d
+		}
+		c.mu.Unlock()
+
+		if leader {
+			c.runDiscovery(ctx, d)
+		} else {
+			select {
+			case <-d.done:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		// A discovery failing because its caller's context ended is retried by the others.
+		if d.err != nil && (leader || !d.cancelled) {
+			return nil, d.err
+		}
+	}
+}
+
+// runDiscovery discovers the provider and sets the verifier of its ID tokens, then
+// completes d. The endpoints of the configuration are only set once the discovery
+// succeeds, along with the verifier, so that they are never read while being set.
+func (c *Client) runDiscovery(ctx context.Context, d *discovery) {
+	defer close(d.done)
+
+	cfg := c.cfg
+	var hmacSecret []byte
+	if cfg.AuthURL == "" || cfg.TokenURL == "" || cfg.UserInfoURL == "" || cfg.JWKSURL == "" {
+		metadata, err := c.discover(ctx)
+		if err == nil {
+			err = metadata.fill(&cfg)
+		}
+		if err != nil {
+			c.mu.Lock()
+			c.discovering = nil
+			d.err, d.cancelled = err, ctx.Err() != nil
+			c.mu.Unlock()
+			return
+		}
+		if slices.Contains(metadata.SigningAlgs, "HS256") {
+			hmacSecret = []byte(cfg.ClientSecret)
+		}
+	}
+	verifier, err := lrjwt.NewVerifier(lrjwt.Config{
+		JWKSURL:    cfg.JWKSURL,
+		HMACSecret: hmacSecret,
+		Issuer:     cfg.Issuer,
+		Audience:   cfg.ClientID,
+		ClockSkew:  cfg.ClockSkew,
+		HTTPClient: cfg.HTTPClient,
+		Cache:      cfg.Cache,
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.discovering = nil
+	if err != nil {
+		d.err = err
+		return
+	}
+	c.cfg = cfg
+	c.verifier = verifier
+}
+
+// discover fetches the discovery document of the issuer.
+func (c *Client) discover(ctx context.Context) (*providerMetadata, error) {
+	res, err := c.cfg.HTTPClient.SendWithContext(ctx, httprutils.Request{
+		Method:   httprutils.Get,
+		URL:      strings.TrimSuffix(c.cfg.Issuer, "/") + "/.well-known/openid-configuration",
+		Headers:  httprutils.JSONHeader,
+		Endpoint: httprutils.Endpoint{Operation: "lroidc.Discover"},
+	})
+	if err != nil {
+		return nil, lrerror.New(lrerror.CodeInitialization, "Error fetching the OpenID configuration", err)
+	}
+	var metadata providerMetadata
+	if err := json.Unmarshal(res.OrigBody, &metadata); err != nil {
+		return nil, lrerror.New(lrerror.CodeInitialization, "Error decoding the OpenID configuration", err)
+	}
+	if metadata.Issuer != c.cfg.Issuer {
+		errMsg := "OpenID configuration is for another issuer: " + metadata.Issuer
+		return nil, lrerror.New(lrerror.CodeInitialization, errMsg, errors.New(errMsg))
+	}
+	return &metadata, nil
+}
+
+// fill fills the endpoints of cfg not configured from the discovery document.
+func (m *providerMetadata) fill(cfg *Config) error {
+	for _, endpoint := range []struct {
+		configured *string
+		discovered string
+	}{
+		{&cfg.AuthURL, m.AuthorizationEndpoint},
+		{&cfg.TokenURL, m.TokenEndpoint},
+		{&cfg.UserInfoURL, m.UserInfoEndpoint},
+		{&cfg.JWKSURL, m.JWKSURI},
+	} {
+		if *endpoint.configured == "" {
+			*endpoint.configured = endpoint.discovered
+		}
+	}
+	if cfg.AuthURL == "" || cfg.TokenURL == "" {
+		errMsg := "OpenID configuration has no authorization or token endpoint"
+		return lrerror.New(lrerror.CodeInitialization, errMsg, errors.New(errMsg))
+	}
+	return nil
+}
+
+// randomString returns a random base64url string, used for states, nonces and code
+// verifiers. Its 43 characters are the minimum length of a PKCE code verifier.
+func randomString() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,318 @@
+package lroidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/lrerror"
+	"github.com/LoginRadius/go-sdk/lrjwt"
+	"github.com/LoginRadius/go-sdk/lrtoken"
+)
+
+// testProvider is an OpenID Connect provider issuing the code "code" for the last
+// authorization request, and the refresh token "refresh-n" on the n-th token call.
+type testProvider struct {
+	*httptest.Server
+	t   *testing.T
+	key *rsa.PrivateKey
+
+	challenge string
+	nonce     string
+	calls     int
+
+	// hs256 makes the provider advertise and sign ID tokens with HS256, keyed with the
+	// client secret.
+	hs256       bool
+	discoveries int32
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &testProvider{t: t, key: key}
+	p.Server = httptest.NewServer(p)
+	return p
+}
+
+func (p *testProvider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/.well-known/openid-configuration":
+		atomic.AddInt32(&p.discoveries, 1)
+		time.Sleep(10 * time.Millisecond)
+		algs := []string{"RS256"}
+		if p.hs256 {
+			algs = append(algs, "HS256")
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                                p.URL,
+			"authorization_endpoint":                p.URL + "/authorize",
+			"token_endpoint":                        p.URL + "/token",
+			"userinfo_endpoint":                     p.URL + "/userinfo",
+			"jwks_uri":                              p.URL + "/jwks",
+			"id_token_signing_alg_values_supported": algs,
+		})
+	case "/jwks":
+		fmt.Fprintf(w, `{"keys": [{"kty": "RSA", "kid": "key", "use": "sig", "n": %q, "e": %q}]}`,
+			base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+			base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()))
+	case "/token":
+		r.ParseForm()
+		if r.PostForm.Get("client_id") != "client" || r.PostForm.Get("client_secret") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error": "invalid_client"}`)
+			return
+		}
+		switch r.PostForm.Get("grant_type") {
+		case "authorization_code":
+			verifier := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+			if r.PostForm.Get("code") != "code" || base64.RawURLEncoding.EncodeToString(verifier[:]) != p.challenge {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error": "invalid_grant"}`)
+				return
+			}
+		case "refresh_token":
+			if r.PostForm.Get("refresh_token") != fmt.Sprintf("refresh-%d", p.calls) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error": "invalid_grant"}`)
+				return
+			}
+		}
+		p.calls++
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  fmt.Sprintf("access-%d", p.calls),
+			"token_type":    "Bearer",
+			"refresh_token": fmt.Sprintf("refresh-%d", p.calls),
+			"expires_in":    3600,
+			"id_token": p.sign(map[string]interface{}{
+				"iss":         p.URL,
+				"sub":         "test-uid",
+				"aud":         "client",
+				"exp":         time.Now().Add(time.Hour).Unix(),
+				"nonce":       p.nonce,
+				"email":       "user@example.com",
+				"given_name":  "Test",
+				"family_name": "User",
+			}),
+		})
+	case "/userinfo":
+		if r.Header.Get("Authorization") != fmt.Sprintf("Bearer access-%d", p.calls) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"sub": "test-uid", "email": "user@example.com", "email_verified": true, "name": "Test User", "Company": "LoginRadius"}`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// authorize plays the authorization endpoint, returning the callback of the request.
+func (p *testProvider) authorize(auth *AuthRequest) url.Values {
+	authURL, _ := url.Parse(auth.URL)
+	query := authURL.Query()
+	if query.Get("code_challenge_method") != "S256" || query.Get("redirect_uri") != "https://example.com/callback" {
+		p.t.Errorf("Expected a PKCE authorization request, got %s", auth.URL)
+	}
+	p.challenge, p.nonce = query.Get("code_challenge"), query.Get("nonce")
+	return url.Values{"code": {"code"}, "state": {query.Get("state")}}
+}
+
+func (p *testProvider) sign(claims map[string]interface{}) string {
+	alg := "RS256"
+	if p.hs256 {
+		alg = "HS256"
+	}
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": "key", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	if p.hs256 {
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte(signed))
+		return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+	}
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func hasCode(err error, code string) bool {
+	var lrErr lrerror.Error
+	return errors.As(err, &lrErr) && lrErr.Code() == code
+}
+
+func newTestClient(t *testing.T, provider *testProvider) *Client {
+	client, err := New(Config{Issuer: provider.URL, ClientID: "client", ClientSecret: "secret", RedirectURL: "https://example.com/callback"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestAuthorizationCodeFlow(t *testing.T) {
+	provider := newTestProvider(t)
+	defer provider.Close()
+	client := newTestClient(t, provider)
+	ctx := context.Background()
+
+	auth, err := client.AuthCodeURL(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokens, err := client.Exchange(ctx, auth, provider.authorize(auth))
+	if err != nil {
+		t.Fatalf("Expected the code to be exchanged, got: %v", err)
+	}
+	if tokens.AccessToken != "access-1" || tokens.RefreshToken != "refresh-1" || time.Until(tokens.Expiry) < 59*time.Minute {
+		t.Errorf("Expected the tokens of the token response, got %+v", tokens)
+	}
+	profile := tokens.Profile()
+	if profile.UID != "test-uid" || profile.FirstName != "Test" || profile.LastName != "User" || len(profile.Email) != 1 || profile.Email[0].Value != "user@example.com" {
+		t.Errorf("Expected the profile of the ID token, got %+v", profile)
+	}
+
+	profile, err = client.UserInfo(ctx, tokens.AccessToken)
+	if err != nil || profile.UID != "test-uid" || profile.FullName != "Test User" || !profile.EmailVerified || profile.Company != "LoginRadius" {
+		t.Errorf("Expected the profile of the userinfo response, got %+v, %v", profile, err)
+	}
+
+	refreshed, err := client.Refresh(ctx, tokens.RefreshToken)
+	if err != nil || refreshed.AccessToken != "access-2" || refreshed.RefreshToken != "refresh-2" {
+		t.Errorf("Expected the tokens to be refreshed, got %+v, %v", refreshed, err)
+	}
+}
+
+func TestExchangeRejectsMismatchedCallbacks(t *testing.T) {
+	provider := newTestProvider(t)
+	defer provider.Close()
+	client := newTestClient(t, provider)
+	ctx := context.Background()
+
+	auth, _ := client.AuthCodeURL(ctx)
+	callback := provider.authorize(auth)
+
+	forged := url.Values{"code": {"code"}, "state": {"forged"}}
+	if _, err := client.Exchange(ctx, auth, forged); !hasCode(err, CodeAuthorization) {
+		t.Errorf("Expected a callback with another state to be rejected, got: %v", err)
+	}
+	denied := url.Values{"error": {"access_denied"}, "state": {auth.State}}
+	if _, err := client.Exchange(ctx, auth, denied); !hasCode(err, CodeAuthorization) {
+		t.Errorf("Expected a callback reporting an error to be rejected, got: %v", err)
+	}
+
+	other, _ := client.AuthCodeURL(ctx)
+	if _, err := client.Exchange(ctx, &AuthRequest{State: auth.State, Nonce: auth.Nonce, CodeVerifier: other.CodeVerifier}, callback); err == nil {
+		t.Error("Expected the code to be rejected with another code verifier")
+	}
+	replayed := *auth
+	replayed.Nonce = other.Nonce
+	if _, err := client.Exchange(ctx, &replayed, callback); !hasCode(err, lrjwt.CodeInvalidToken) {
+		t.Errorf("Expected an ID token with another nonce to be rejected, got: %v", err)
+	}
+}
+
+func TestHS256IDTokens(t *testing.T) {
+	for _, advertised := range []bool{false, true} {
+		provider := newTestProvider(t)
+		client := newTestClient(t, provider)
+		ctx := context.Background()
+
+		provider.hs256 = advertised
+		auth, _ := client.AuthCodeURL(ctx)
+		// An ID token signed with the client secret, which is not a signing key unless
+		// the provider advertises HS256.
+		provider.hs256 = true
+		_, err := client.Exchange(ctx, auth, provider.authorize(auth))
+		if advertised && err != nil {
+			t.Errorf("Expected HS256 ID tokens to be verified when advertised, got: %v", err)
+		}
+		if !advertised && !hasCode(err, lrjwt.CodeInvalidToken) {
+			t.Errorf("Expected HS256 ID tokens to be rejected when not advertised, got: %v", err)
+		}
+		provider.Close()
+	}
+}
+
+func TestDiscoveryShared(t *testing.T) {
+	provider := newTestProvider(t)
+	defer provider.Close()
+	client := newTestClient(t, provider)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.AuthCodeURL(context.Background()); err != nil {
+				t.Errorf("Expected the provider to be discovered, got: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := atomic.LoadInt32(&provider.discoveries); n != 1 {
+		t.Errorf("Expected concurrent calls to share a single discovery, got %d", n)
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	other, _ := New(Config{Issuer: provider.URL, ClientID: "client", RedirectURL: "https://example.com/callback"})
+	if _, err := other.AuthCodeURL(cancelled); err == nil {
+		t.Error("Expected the discovery to fail with a cancelled context")
+	}
+	if _, err := other.AuthCodeURL(context.Background()); err != nil {
+		t.Errorf("Expected a failed discovery to be retried, got: %v", err)
+	}
+}
+
+func TestRefreshFunc(t *testing.T) {
+	provider := newTestProvider(t)
+	defer provider.Close()
+	client := newTestClient(t, provider)
+	ctx := context.Background()
+
+	auth, _ := client.AuthCodeURL(ctx)
+	tokens, err := client.Exchange(ctx, auth, provider.authorize(auth))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := lrtoken.New(lrtoken.Config{Token: tokens.Token(), Refresh: client.RefreshFunc()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	refreshed, err := source.Refresh(ctx)
+	if err != nil || refreshed.AccessToken != "access-2" || refreshed.RefreshToken != "refresh-2" {
+		t.Errorf("Expected the token source to refresh the tokens with the provider, got %+v, %v", refreshed, err)
+	}
+}
+
+func TestNewRequiresSettings(t *testing.T) {
+	if _, err := New(Config{Issuer: "https://example.com", ClientID: "client"}); !errors.Is(err, lrerror.ErrInitialization) {
+		t.Errorf("Expected New to require a redirect URL, got: %v", err)
+	}
+
+	provider := newTestProvider(t)
+	defer provider.Close()
+	client, _ := New(Config{Issuer: provider.URL + "/other", ClientID: "client", RedirectURL: "https://example.com/callback"})
+	if _, err := client.AuthCodeURL(context.Background()); !errors.Is(err, lrerror.ErrInitialization) {
+		t.Errorf("Expected the discovery to fail for an unknown issuer, got: %v", err)
+	}
+}
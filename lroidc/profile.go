@@ -0,0 +1,62 @@
+package lroidc
+
+import (
+	"encoding/json"
+
+	"github.com/LoginRadius/go-sdk/lrjson"
+)
+
+// profileFields maps the standard OpenID Connect claims to the fields of the LoginRadius
+// profile, by their JSON names.
+var profileFields = map[string]string{
+	"sub":                   "Uid",
+	"preferred_username":    "UserName",
+	"name":                  "FullName",
+	"given_name":            "FirstName",
+	"middle_name":           "MiddleName",
+	"family_name":           "LastName",
+	"nickname":              "NickName",
+	"picture":               "ImageUrl",
+	"profile":               "ProfileUrl",
+	"website":               "Website",
+	"gender":                "Gender",
+	"birthdate":             "BirthDate",
+	"zoneinfo":              "TimeZone",
+	"locale":                "LocalLanguage",
+	"email_verified":        "EmailVerified",
+	"phone_number":          "PhoneId",
+	"phone_number_verified": "PhoneIdVerified",
+}
+
+// ProfileFromClaims returns the profile held in the claims of an ID token or of a
+// userinfo response, in the type returned by GetAuthReadProfilesByToken.
+//
+// The standard claims are mapped to the matching profile fields, the email claim being
+// the primary email. Claims named after LoginRadius profile fields, such as those added
+// to the userinfo response of LoginRadius, are decoded as is and take precedence.
+func ProfileFromClaims(claims map[string]interface{}) *lrjson.Profile {
+	fields := map[string]interface{}{}
+	for claim, field := range profileFields {
+		if value, ok := claims[claim]; ok {
+			fields[field] = value
+		}
+	}
+	if email, ok := claims["email"].(string); ok && email != "" {
+		fields["Email"] = []map[string]string{{"Type": "Primary", "Value": email}}
+	}
+	for name, value := range claims {
+		if _, standard := profileFields[name]; !standard && name != "email" {
+			fields[name] = value
+		}
+	}
+
+	profile := &lrjson.Profile{}
+	// Decode each field on its own, so that a claim not matching the type of the field
+	// does not prevent the others from being decoded.
+	for name, value := range fields {
+		if data, err := json.Marshal(map[string]interface{}{name: value}); err == nil {
+			json.Unmarshal(data, profile)
+		}
+	}
+	return profile
+}
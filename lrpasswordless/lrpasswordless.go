@@ -0,0 +1,213 @@
+// Package lrpasswordless runs passwordless logins, where the user logs in with a link sent
+// by email or with a one-time password sent by SMS rather than with a password.
+//
+// A Flow sends the link or OTP, and verifies the link token or OTP to return the session of
+// the user. Its Handler serves the verification URL the links point to:
+//
+//	flow, err := lrpasswordless.New(lrpasswordless.Config{
+//		Client:          lrclient,
+//		VerificationURL: "https://example.com/passwordless/verify",
+//		OnLogin: func(w http.ResponseWriter, r *http.Request, session *lrjson.AccessToken) {
+//			// start the session of session.Profile.UID
+//			http.Redirect(w, r, "/", http.StatusFound)
+//		},
+//	})
+//	http.Handle("/passwordless/verify", flow.Handler())
+//
+//	// In the login handler:
+//	err = flow.SendLinkByEmail(ctx, email)
+package lrpasswordless
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	lr "github.com/LoginRadius/go-sdk"
+	lrauthentication "github.com/LoginRadius/go-sdk/api/authentication"
+	"github.com/LoginRadius/go-sdk/api/phoneauthentication"
+	"github.com/LoginRadius/go-sdk/httprutils"
+	"github.com/LoginRadius/go-sdk/lrerror"
+	"github.com/LoginRadius/go-sdk/lrjson"
+)
+
+// TokenParams are the query parameters the Handler reads the verification token from, in
+// order. LoginRadius appends vtoken to the verification URL of the links it sends.
+var TokenParams = []string{"vtoken", "verification_token"}
+
+// Config holds the settings of a Flow.
+type Config struct {
+	// Client is the LoginRadius client used to call the Passwordless Login APIs.
+	Client *lr.Loginradius
+
+	// VerificationURL, when set, is the URL the links sent by email point to, typically
+	// where the Handler is served. The URL configured for the site is used otherwise.
+	VerificationURL string
+
+	// EmailTemplate, WelcomeEmailTemplate and SMSTemplate, when set, are the names of the
+	// templates of the link email, of the welcome email sent on first login, and of the
+	// OTP SMS.
+	EmailTemplate        string
+	WelcomeEmailTemplate string
+	SMSTemplate          string
+
+	// OnLogin writes the response of the Handler once the user is logged in, e.g. starting
+	// their session and redirecting them. It is required, so that the access token of the
+	// session is never written to the browser unless the application does so itself.
+	OnLogin func(w http.ResponseWriter, r *http.Request, session *lrjson.AccessToken)
+
+	// ErrorHandler writes the response of the Handler when the login fails. status is
+	// http.StatusBadRequest for requests without token, http.StatusUnauthorized for
+	// invalid or expired links, and http.StatusBadGateway when LoginRadius could not be
+	// reached. By default it writes the status text.
+	ErrorHandler func(w http.ResponseWriter, r *http.Request, err error, status int)
+}
+
+// A Flow runs passwordless logins. It holds no state and is safe for concurrent use.
+type Flow struct {
+	cfg Config
+}
+
+// New returns a Flow configured with cfg.
+func New(cfg Config) (*Flow, error) {
+	if cfg.Client == nil {
+		errMsg := "Must initialize the passwordless flow with a Loginradius client"
+		return nil, lrerror.New(lrerror.CodeInitialization, errMsg, errors.New(errMsg))
+	}
+	if cfg.OnLogin == nil {
+		errMsg := "Must initialize the passwordless flow with an OnLogin function starting the session of the user"
+		return nil, lrerror.New(lrerror.CodeInitialization, errMsg, errors.New(errMsg))
+	}
+	if cfg.ErrorHandler == nil {
+		cfg.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error, status int) {
+			http.Error(w, http.StatusText(status), status)
+		}
+	}
+	return &Flow{cfg: cfg}, nil
+}
+
+// SendLinkByEmail sends a login link to email with GetPasswordlessLoginByEmail.
+func (f *Flow) SendLinkByEmail(ctx context.Context, email string) error {
+	_, err := lrauthentication.Loginradius{Client: f.cfg.Client}.GetPasswordlessLoginByEmailWithContext(ctx, f.linkQueries("email", email))
+	return err
+}
+
+// SendLinkByUsername sends a login link to the email of the user with the given username,
+// with GetPasswordlessLoginByUsername.
+func (f *Flow) SendLinkByUsername(ctx context.Context, username string) error {
+	_, err := lrauthentication.Loginradius{Client: f.cfg.Client}.GetPasswordlessLoginByUsernameWithContext(ctx, f.linkQueries("username", username))
+	return err
+}
+
+// SendOTP sends a one-time password to phone with GetPhoneSendOTP.
+func (f *Flow) SendOTP(ctx context.Context, phone string) error {
+	queries := map[string]string{"phone": phone}
+	if f.cfg.SMSTemplate != "" {
+		queries["smstemplate"] = f.cfg.SMSTemplate
+	}
+	_, err := phoneauthentication.Loginradius{Client: f.cfg.Client}.GetPhoneSendOTPWithContext(ctx, queries)
+	return err
+}
+
+// VerifyLink logs the user in with the verification token of a login link, with
+// GetPasswordlessLoginVerification, and returns their session. The verification is a
+// GET call consuming the token, so it is never served from the ReadCache of the client.
+func (f *Flow) VerifyLink(ctx context.Context, token string) (*lrjson.AccessToken, error) {
+	if token == "" {
+		errMsg := "Must verify a passwordless login with a verification token"
+		return nil, lrerror.New(lrerror.CodeValidation, errMsg, errors.New(errMsg))
+	}
+	queries := map[string]string{"verificationtoken": token}
+	if f.cfg.WelcomeEmailTemplate != "" {
+		queries["welcomeemailtemplate"] = f.cfg.WelcomeEmailTemplate
+	}
+	res, err := lrauthentication.Loginradius{Client: f.uncachedClient()}.GetPasswordlessLoginVerificationWithContext(ctx, queries)
+	return session(res, err)
+}
+
+// VerifyOTP logs the user in with the one-time password sent to phone, with
+// PutPhoneLoginUsingOTP, and returns their session.
+func (f *Flow) VerifyOTP(ctx context.Context, phone, otp string) (*lrjson.AccessToken, error) {
+	if phone == "" || otp == "" {
+		errMsg := "Must verify a passwordless login with a phone number and an OTP"
+		return nil, lrerror.New(lrerror.CodeValidation, errMsg, errors.New(errMsg))
+	}
+	var queries []interface{}
+	if f.cfg.SMSTemplate != "" {
+		queries = append(queries, map[string]string{"smstemplate": f.cfg.SMSTemplate})
+	}
+	res, err := phoneauthentication.Loginradius{Client: f.cfg.Client}.PutPhoneLoginUsingOTPWithContext(ctx,
+		map[string]string{"phone": phone, "otp": otp}, queries...)
+	return session(res, err)
+}
+
+// Handler returns a handler serving the verification URL of the login links: it verifies
+// the token of the link and passes the session of the user to OnLogin.
+func (f *Flow) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var token string
+		for _, param := range TokenParams {
+			if token = r.URL.Query().Get(param); token != "" {
+				break
+			}
+		}
+		if token == "" {
+			errMsg := "Request has no verification token"
+			f.cfg.ErrorHandler(w, r, lrerror.New(lrerror.CodeValidation, errMsg, errors.New(errMsg)), http.StatusBadRequest)
+			return
+		}
+
+		session, err := f.VerifyLink(r.Context(), token)
+		if err != nil {
+			status := http.StatusBadGateway
+			var apiErr *lrerror.APIError
+			if errors.As(err, &apiErr) && apiErr.StatusCode < 500 {
+				status = http.StatusUnauthorized
+			}
+			f.cfg.ErrorHandler(w, r, err, status)
+			return
+		}
+		f.cfg.OnLogin(w, r, session)
+	})
+}
+
+// uncachedClient returns the client of the flow, or a copy of it without ReadCache if
+// it has one.
+func (f *Flow) uncachedClient() *lr.Loginradius {
+	if f.cfg.Client.HTTPRClient == nil || f.cfg.Client.HTTPRClient.ReadCache == nil {
+		return f.cfg.Client
+	}
+	client := *f.cfg.Client
+	httprClient := *client.HTTPRClient
+	httprClient.ReadCache = nil
+	client.HTTPRClient = &httprClient
+	return &client
+}
+
+// linkQueries returns the query parameters of the APIs sending login links.
+func (f *Flow) linkQueries(identifier, value string) map[string]string {
+	queries := map[string]string{identifier: value}
+	if f.cfg.VerificationURL != "" {
+		queries["verificationurl"] = f.cfg.VerificationURL
+	}
+	if f.cfg.EmailTemplate != "" {
+		queries["passwordlesslogintemplate"] = f.cfg.EmailTemplate
+	}
+	return queries
+}
+
+// session decodes the session returned by the verification APIs.
+func session(res *httprutils.Response, err error) (*lrjson.AccessToken, error) {
+	if err != nil {
+		return nil, err
+	}
+	session, err := lrjson.DecodeAs[lrjson.AccessToken](res)
+	if err != nil {
+		return nil, err
+	}
+	if session.AccessToken == "" {
+		errMsg := "LoginRadius returned no access token"
+		return nil, lrerror.New(lrerror.CodeEncoding, "Error decoding the passwordless login session", errors.New(errMsg))
+	}
+	return &session, nil
+}
@@ -0,0 +1,203 @@
+package lrpasswordless
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	lr "github.com/LoginRadius/go-sdk"
+	"github.com/LoginRadius/go-sdk/httprutils"
+	"github.com/LoginRadius/go-sdk/lrerror"
+	"github.com/LoginRadius/go-sdk/lrjson"
+)
+
+const sessionBody = `{"access_token": "access", "refresh_token": "refresh", "Profile": {"Uid": "test-uid"}}`
+
+// loginradiusStub serves the Passwordless Login APIs, recording the queries of the calls
+// sending links and OTPs. The verification token "valid" and the OTP "123456" are valid.
+type loginradiusStub struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	sent          []url.Values
+	verifications int
+}
+
+func newLoginradiusStub() *loginradiusStub {
+	stub := &loginradiusStub{}
+	stub.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /identity/v2/auth/login/passwordlesslogin/email", "GET /identity/v2/auth/login/passwordlesslogin/otp":
+			stub.mu.Lock()
+			stub.sent = append(stub.sent, r.URL.Query())
+			stub.mu.Unlock()
+			fmt.Fprint(w, `{"IsPosted": true}`)
+		case "GET /identity/v2/auth/login/passwordlesslogin/email/verify":
+			stub.mu.Lock()
+			stub.verifications++
+			stub.mu.Unlock()
+			if r.URL.Query().Get("verificationtoken") != "valid" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"ErrorCode": 975, "Message": "Verification token is invalid"}`)
+				return
+			}
+			fmt.Fprint(w, sessionBody)
+		case "PUT /identity/v2/auth/login/passwordlesslogin/otp/verify":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["phone"] != "+15550000000" || body["otp"] != "123456" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"ErrorCode": 1066, "Message": "OTP is invalid"}`)
+				return
+			}
+			fmt.Fprint(w, sessionBody)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return stub
+}
+
+func newTestFlow(t *testing.T, stub *loginradiusStub, cfg Config) *Flow {
+	cfg.Client, _ = lr.NewLoginradius(&lr.Config{ApiKey: "abcd1234", ApiSecret: "abcd1234"})
+	cfg.Client.Domain = stub.URL
+	if cfg.OnLogin == nil {
+		cfg.OnLogin = func(w http.ResponseWriter, r *http.Request, session *lrjson.AccessToken) {
+			http.Redirect(w, r, "/", http.StatusFound)
+		}
+	}
+	flow, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return flow
+}
+
+func TestSend(t *testing.T) {
+	stub := newLoginradiusStub()
+	defer stub.Close()
+	flow := newTestFlow(t, stub, Config{VerificationURL: "https://example.com/verify", EmailTemplate: "link", SMSTemplate: "otp"})
+	ctx := context.Background()
+
+	if err := flow.SendLinkByEmail(ctx, "user@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if err := flow.SendLinkByUsername(ctx, "user"); err != nil {
+		t.Fatal(err)
+	}
+	if err := flow.SendOTP(ctx, "+15550000000"); err != nil {
+		t.Fatal(err)
+	}
+	if len(stub.sent) != 3 {
+		t.Fatalf("Expected 3 links and OTPs to be sent, got %d", len(stub.sent))
+	}
+	for i, identifier := range []string{"email", "username"} {
+		if stub.sent[i].Get(identifier) == "" || stub.sent[i].Get("verificationurl") != "https://example.com/verify" || stub.sent[i].Get("passwordlesslogintemplate") != "link" {
+			t.Errorf("Expected the link to be sent by %s to the verification URL, got %v", identifier, stub.sent[i])
+		}
+	}
+	if stub.sent[2].Get("phone") != "+15550000000" || stub.sent[2].Get("smstemplate") != "otp" {
+		t.Errorf("Expected the OTP to be sent to the phone, got %v", stub.sent[2])
+	}
+}
+
+func TestVerify(t *testing.T) {
+	stub := newLoginradiusStub()
+	defer stub.Close()
+	flow := newTestFlow(t, stub, Config{})
+	ctx := context.Background()
+
+	session, err := flow.VerifyLink(ctx, "valid")
+	if err != nil || session.AccessToken != "access" || session.Profile.UID != "test-uid" {
+		t.Errorf("Expected the session of the link, got %+v, %v", session, err)
+	}
+	var apiErr *lrerror.APIError
+	if _, err := flow.VerifyLink(ctx, "expired"); !errors.As(err, &apiErr) {
+		t.Errorf("Expected an invalid link to be rejected, got: %v", err)
+	}
+
+	session, err = flow.VerifyOTP(ctx, "+15550000000", "123456")
+	if err != nil || session.AccessToken != "access" || session.Profile.UID != "test-uid" {
+		t.Errorf("Expected the session of the OTP, got %+v, %v", session, err)
+	}
+	if _, err := flow.VerifyOTP(ctx, "+15550000000", ""); !errors.Is(err, lrerror.ErrValidation) {
+		t.Errorf("Expected an OTP to be required, got: %v", err)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	stub := newLoginradiusStub()
+	defer stub.Close()
+	var loggedIn *lrjson.AccessToken
+	var status int
+	flow := newTestFlow(t, stub, Config{
+		OnLogin: func(w http.ResponseWriter, r *http.Request, session *lrjson.AccessToken) {
+			loggedIn = session
+			http.Redirect(w, r, "/", http.StatusFound)
+		},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error, s int) {
+			status = s
+			w.WriteHeader(s)
+		},
+	})
+
+	cases := []struct {
+		query  string
+		status int
+	}{
+		{"?vtype=oneclicksignin&vtoken=valid", http.StatusFound},
+		{"?verification_token=valid", http.StatusFound},
+		{"?vtoken=expired", http.StatusUnauthorized},
+		{"", http.StatusBadRequest},
+	}
+	for _, c := range cases {
+		loggedIn, status = nil, 0
+		w := httptest.NewRecorder()
+		flow.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/verify"+c.query, nil))
+		if w.Code != c.status {
+			t.Errorf("Expected status %d for %q, got %d", c.status, c.query, w.Code)
+		}
+		if valid := c.status == http.StatusFound; (loggedIn != nil) != valid || (status == 0) != valid {
+			t.Errorf("Expected OnLogin to be called for valid links and ErrorHandler otherwise, got %+v and %d for %q", loggedIn, status, c.query)
+		}
+	}
+}
+
+func TestHandlerDefaults(t *testing.T) {
+	stub := newLoginradiusStub()
+	defer stub.Close()
+	flow := newTestFlow(t, stub, Config{})
+
+	w := httptest.NewRecorder()
+	flow.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/verify?vtoken=expired", nil))
+	if w.Code != http.StatusUnauthorized || w.Body.String() != "Unauthorized\n" {
+		t.Errorf("Expected an invalid link to be rejected, got %d and %q", w.Code, w.Body.String())
+	}
+
+	if _, err := New(Config{}); !errors.Is(err, lrerror.ErrInitialization) {
+		t.Errorf("Expected New to require a client, got: %v", err)
+	}
+	if _, err := New(Config{Client: flow.cfg.Client}); !errors.Is(err, lrerror.ErrInitialization) {
+		t.Errorf("Expected New to require OnLogin, got: %v", err)
+	}
+}
+
+func TestVerifyLinkBypassesReadCache(t *testing.T) {
+	stub := newLoginradiusStub()
+	defer stub.Close()
+	flow := newTestFlow(t, stub, Config{})
+	cache := &httprutils.ReadCache{Cacheable: func(*http.Request, httprutils.Endpoint) bool { return true }}
+	flow.cfg.Client.HTTPRClient = &httprutils.Client{HTTPClient: httprutils.NetClient, ReadCache: cache}
+
+	flow.VerifyLink(context.Background(), "valid")
+	flow.VerifyLink(context.Background(), "valid")
+	if stub.verifications != 2 || cache.Len() != 0 {
+		t.Errorf("Expected every verification to reach LoginRadius, got %d calls", stub.verifications)
+	}
+}
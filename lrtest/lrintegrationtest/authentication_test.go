@@ -39,7 +39,7 @@ func TestPostAuthUserRegistrationByEmail(t *testing.T) {
 
 	lrclient, _ := lr.NewLoginradius(&cfg)
 	loginradius := lrauthentication.Loginradius{lrclient}
-	sottToken := sott.Generate(lrclient.Context.ApiKey, lrclient.Context.ApiSecret, "10", "", "")
+	sottToken, _ := sott.Generate(lrclient.Context.ApiKey, lrclient.Context.ApiSecret, "10", "", "")
 
 	testEmail := "lrtest" + strconv.FormatInt(time.Now().Unix(), 10) + "@mailinator.com"
 	user := lrbody.RegistrationUser{}
@@ -112,7 +112,7 @@ func TestPostPhoneUserRegistrationBySMS(t *testing.T) {
 
 	lrclient, _ := lr.NewLoginradius(&cfg)
 	loginradius := phoneauthentication.Loginradius{lrclient}
-	sottToken := sott.Generate(lrclient.Context.ApiKey, lrclient.Context.ApiSecret, "10", "", "")
+	sottToken, _ := sott.Generate(lrclient.Context.ApiKey, lrclient.Context.ApiSecret, "10", "", "")
 
 	testEmail := "lrtest" + strconv.FormatInt(time.Now().Unix(), 10) + "@mailinator.com"
 	user := User{}
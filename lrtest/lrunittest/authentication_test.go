@@ -1,13 +1,17 @@
 package lrunittest
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	lr "github.com/LoginRadius/go-sdk"
 	lrauth "github.com/LoginRadius/go-sdk/api/authentication"
 	"github.com/LoginRadius/go-sdk/httprutils"
+	"github.com/LoginRadius/go-sdk/lrerror"
 )
 
 const body = "test body"
@@ -46,3 +50,82 @@ func TestGetAuthVerifyEmail(t *testing.T) {
 		t.Errorf("Unit TestPostAuthUserRegistrationByEmail: received %v", res)
 	}
 }
+
+func TestGetAuthVerifyEmailWithContext(t *testing.T) {
+	lr, stub := initTest("/identity/v2/auth/email")
+	defer stub.Close()
+	res, err := lrauth.Loginradius(lrauth.Loginradius{&lr}).GetAuthVerifyEmailWithContext(
+		context.Background(),
+		map[string]string{"verificationtoken": "abcd"},
+	)
+	if err != nil || res.StatusCode != 200 || res.Body != body {
+		t.Errorf("Unit TestGetAuthVerifyEmailWithContext: received %v, %v", res, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = lrauth.Loginradius(lrauth.Loginradius{&lr}).GetAuthVerifyEmailWithContext(
+		ctx,
+		map[string]string{"verificationtoken": "abcd"},
+	)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Unit TestGetAuthVerifyEmailWithContext: expected cancelled request, received %v", err)
+	}
+}
+
+type spanNames []string
+
+func (s *spanNames) Start(ctx context.Context, name string) (context.Context, httprutils.Span) {
+	*s = append(*s, name)
+	return ctx, noopSpan{}
+}
+
+func (s *spanNames) Inject(ctx context.Context, header http.Header) {}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(key string, value interface{}) {}
+func (noopSpan) RecordError(err error)                      {}
+func (noopSpan) End()                                       {}
+
+func TestGetAuthVerifyEmailTraced(t *testing.T) {
+	lr, stub := initTest("/identity/v2/auth/email")
+	defer stub.Close()
+	names := &spanNames{}
+	lr.HTTPRClient = &httprutils.Client{HTTPClient: http.DefaultClient, Tracer: names}
+
+	_, err := lrauth.Loginradius(lrauth.Loginradius{&lr}).GetAuthVerifyEmail(map[string]string{"verificationtoken": "abcd"})
+	if err != nil || len(*names) != 1 || (*names)[0] != "lrauthentication.GetAuthVerifyEmail" {
+		t.Errorf("Unit TestGetAuthVerifyEmailTraced: expected a span named after the SDK method, received %v, %v", *names, err)
+	}
+}
+
+func TestPostAuthLoginByEmailTyped(t *testing.T) {
+	stub := initTestServer("/identity/v2/auth/login", httprutils.Response{
+		StatusCode: 200,
+		Body:       `{"access_token": "abcd", "refresh_token": "efgh", "Profile": {"Uid": "test-uid"}}`,
+	})
+	defer stub.Close()
+	lr := initLr()
+	lr.Domain = stub.URL
+
+	session, err := lrauth.Loginradius(lrauth.Loginradius{&lr}).PostAuthLoginByEmailTyped(context.Background(), map[string]string{"email": "test@example.com", "password": "password"})
+	if err != nil || session.AccessToken != "abcd" || session.Profile == nil || session.Profile.UID != "test-uid" {
+		t.Errorf("Unit TestPostAuthLoginByEmailTyped: expected a decoded session, received %+v, %v", session, err)
+	}
+}
+
+func TestPostAuthLoginByEmailTypedError(t *testing.T) {
+	stub := initTestServer("/identity/v2/auth/login", httprutils.Response{
+		StatusCode: 401,
+		Body:       `{"ErrorCode": 1048, "Message": "The account is locked"}`,
+	})
+	defer stub.Close()
+	lr := initLr()
+	lr.Domain = stub.URL
+
+	session, err := lrauth.Loginradius(lrauth.Loginradius{&lr}).PostAuthLoginByEmailTyped(context.Background(), map[string]string{"email": "test@example.com", "password": "password"})
+	if session != nil || !errors.Is(err, lrerror.ErrAccountLocked) {
+		t.Errorf("Unit TestPostAuthLoginByEmailTypedError: expected the error of the call, received %+v, %v", session, err)
+	}
+}
@@ -0,0 +1,33 @@
+package lrunittest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	lr "github.com/LoginRadius/go-sdk"
+	lrconfiguration "github.com/LoginRadius/go-sdk/api/configuration"
+)
+
+func TestConfigurationEndpoints(t *testing.T) {
+	var paths []string
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte("{}"))
+	}))
+	defer stub.Close()
+
+	lrclient := initLr().WithToken("abcd1234")
+	lrclient.Resolver = lr.Endpoints{lr.ConfigAPI: stub.URL, lr.SocialAPI: stub.URL}
+	config := lrconfiguration.Loginradius{Client: lrclient}
+
+	if _, err := config.GetConfiguration(); err != nil {
+		t.Errorf("Unit TestConfigurationEndpoints: GetConfiguration received %v", err)
+	}
+	if _, err := config.GetActiveSessionDetails(); err != nil {
+		t.Errorf("Unit TestConfigurationEndpoints: GetActiveSessionDetails received %v", err)
+	}
+	if len(paths) != 2 || paths[0] != "/ciam/appinfo" || paths[1] != "/api/v2/access_token/activesession" {
+		t.Errorf("Unit TestConfigurationEndpoints: expected calls to the resolved endpoints, received %v", paths)
+	}
+}
@@ -0,0 +1,115 @@
+package lrunittest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	lraccount "github.com/LoginRadius/go-sdk/api/account"
+	lrauthentication "github.com/LoginRadius/go-sdk/api/authentication"
+	lrconfiguration "github.com/LoginRadius/go-sdk/api/configuration"
+	"github.com/LoginRadius/go-sdk/api/customobject"
+	"github.com/LoginRadius/go-sdk/api/mfa"
+	"github.com/LoginRadius/go-sdk/api/onetouchlogin"
+	"github.com/LoginRadius/go-sdk/api/phoneauthentication"
+	"github.com/LoginRadius/go-sdk/api/role"
+	"github.com/LoginRadius/go-sdk/api/smartlogin"
+	lrsocial "github.com/LoginRadius/go-sdk/api/social"
+	"github.com/LoginRadius/go-sdk/api/tokenmanagement"
+	"github.com/LoginRadius/go-sdk/api/webhook"
+	"github.com/LoginRadius/go-sdk/httprutils"
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+// TestNoSecretInURL calls every API method and checks that none sends the API secret
+// in its URL, where it would leak into proxy and access logs, except the endpoints listed
+// in httprutils.SecretInURLOperations, which LoginRadius documents with the secret in a
+// query parameter only.
+func TestNoSecretInURL(t *testing.T) {
+	var leaked bool
+	lrclient := initLr().WithToken("abcd1234")
+	lrclient.HTTPRClient = &httprutils.Client{
+		HTTPClient:        http.DefaultClient,
+		RejectSecretInURL: true,
+		Middleware: []httprutils.Middleware{func(next httprutils.RoundTripFunc) httprutils.RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				if strings.Contains(req.URL.String(), lrclient.Context.ApiSecret) {
+					leaked = true
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+			}
+		}},
+	}
+	// The secret must differ from the other values sent for the check above to be meaningful.
+	lrclient.Context.ApiSecret = "api-secret-value"
+
+	packages := []interface{}{
+		lraccount.Loginradius{Client: lrclient},
+		lrauthentication.Loginradius{Client: lrclient},
+		lrconfiguration.Loginradius{Client: lrclient},
+		customobject.Loginradius{Client: lrclient},
+		mfa.Loginradius{Client: lrclient},
+		onetouchlogin.Loginradius{Client: lrclient},
+		phoneauthentication.Loginradius{Client: lrclient},
+		role.Loginradius{Client: lrclient},
+		smartlogin.Loginradius{Client: lrclient},
+		lrsocial.Loginradius{Client: lrclient},
+		tokenmanagement.Loginradius{Client: lrclient},
+		webhook.Loginradius{Client: lrclient},
+	}
+
+	var called int
+	allowed := map[string]bool{}
+	for _, pkg := range packages {
+		value := reflect.ValueOf(pkg)
+		for i := 0; i < value.NumMethod(); i++ {
+			name := value.Type().Method(i).Name
+			if !strings.HasSuffix(name, "WithContext") {
+				continue
+			}
+			operation := strings.TrimSuffix(value.Type().String(), ".Loginradius") + "." + strings.TrimSuffix(name, "WithContext")
+			leaked = false
+			out := value.Method(i).Call(testArgs(value.Method(i).Type()))
+			if err, _ := out[1].Interface().(error); errors.Is(err, lrerror.ErrSecretInURL) {
+				t.Errorf("%s sends the API secret in its URL", operation)
+			}
+			switch {
+			case leaked && !httprutils.SecretInURLOperations[operation]:
+				t.Errorf("%s sends the API secret in its URL", operation)
+			case leaked:
+				allowed[operation] = true
+			}
+			called++
+		}
+	}
+	if called < 150 {
+		t.Errorf("Expected every API method to be audited, only called %d", called)
+	}
+	for operation := range httprutils.SecretInURLOperations {
+		if !allowed[operation] {
+			t.Errorf("Expected %s to send the API secret in its URL, or to be removed from SecretInURLOperations", operation)
+		}
+	}
+}
+
+// testArgs returns arguments for an API method: a context, then strings and empty maps
+// for the body and query parameters.
+func testArgs(method reflect.Type) []reflect.Value {
+	args := []reflect.Value{reflect.ValueOf(context.Background())}
+	for i := 1; i < method.NumIn(); i++ {
+		if method.IsVariadic() && i == method.NumIn()-1 {
+			break
+		}
+		switch method.In(i).Kind() {
+		case reflect.String:
+			args = append(args, reflect.ValueOf("abcd1234"))
+		default:
+			args = append(args, reflect.ValueOf(map[string]string{}))
+		}
+	}
+	return args
+}
@@ -0,0 +1,328 @@
+// Package lrtoken keeps the access token of a user fresh, refreshing it with its refresh
+// token before it expires.
+//
+// A Source holds the access token, refresh token and expiry of a user, as returned by the
+// login APIs, and returns a valid access token on every call, refreshing it when needed:
+//
+//	session, _ := lrjson.DecodeAs[lrjson.AccessToken](res)
+//	source, err := lrtoken.New(lrtoken.Config{Client: lrclient, Token: lrtoken.FromAccessToken(session)})
+//	if err != nil {
+//		// handle error
+//	}
+//	client, err := source.Client(ctx)
+//	if err != nil {
+//		// handle error
+//	}
+//	res, err = lrauthentication.Loginradius{Client: client}.GetAuthReadProfilesByTokenWithContext(ctx)
+package lrtoken
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	lr "github.com/LoginRadius/go-sdk"
+	lraccount "github.com/LoginRadius/go-sdk/api/account"
+	"github.com/LoginRadius/go-sdk/lrerror"
+	"github.com/LoginRadius/go-sdk/lrjson"
+)
+
+// DefaultRefreshBefore is how long before its expiry the access token is refreshed when
+// Config.RefreshBefore is not set.
+const DefaultRefreshBefore = time.Minute
+
+// Token is the access token of a user, with the refresh token used to renew it.
+type Token struct {
+	AccessToken  string
+	RefreshToken string
+
+	// Expiry is when the access token expires. The zero value means it does not expire.
+	Expiry time.Time
+}
+
+// FromAccessToken returns the Token of a session returned by the login, registration and
+// token APIs.
+func FromAccessToken(session lrjson.AccessToken) *Token {
+	return &Token{AccessToken: session.AccessToken, RefreshToken: session.RefreshToken, Expiry: session.ExpiresIn}
+}
+
+// Valid reports whether the access token is set and has not expired at now.
+func (t *Token) Valid(now time.Time) bool {
+	return t != nil && t.AccessToken != "" && (t.Expiry.IsZero() || now.Before(t.Expiry))
+}
+
+// A TokenSource returns valid access tokens.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// A RefreshFunc exchanges the refresh token of token for a new token.
+type RefreshFunc func(ctx context.Context, token *Token) (*Token, error)
+
+// Config holds the settings of a Source.
+type Config struct {
+	// Client is the LoginRadius client used to refresh and revoke tokens. It does not need
+	// to be initialized with an access token. It may be omitted if Refresh is set, in
+	// which case Logout does not revoke the refresh token.
+	Client *lr.Loginradius
+
+	// Token is the initial token. Its access token may be empty or expired, in which case
+	// it is refreshed on first use. It may be omitted if Store holds the token.
+	Token *Token
+
+	// Store, when set, persists the token under Key: the token is loaded from it if Token
+	// is not set, stored in it initially and after every refresh, and deleted from it on
+	// Logout. A refreshed token that cannot be stored is still used.
+	Store Store
+	Key   string
+
+	// Logger, when set, receives the errors of the Store when a refreshed token cannot be
+	// stored.
+	Logger *slog.Logger
+
+	// RefreshBefore is how long before its expiry the access token is refreshed,
+	// DefaultRefreshBefore by default.
+	RefreshBefore time.Duration
+
+	// Refresh, when set, replaces the refresh-token API, e.g. to refresh the token of a
+	// social provider with tokenmanagement.GetRefreshToken.
+	Refresh RefreshFunc
+
+	// OnRefresh, when set, is called with every refreshed token, e.g. to persist the new
+	// refresh token when LoginRadius rotates it.
+	OnRefresh func(token *Token)
+}
+
+// A Source is a TokenSource refreshing the access token of a user before it expires.
+// It is safe for concurrent use, concurrent calls sharing a single refresh. The refresh
+// is not cancelled with the context of the call that started it, so that a refresh
+// token rotated by LoginRadius is never lost, and runs until the HTTP client times out.
+type Source struct {
+	cfg Config
+	now func() time.Time
+
+	mu         sync.Mutex
+	token      Token
+	refreshing *refreshCall
+}
+
+// refreshCall is a refresh in flight, shared by the calls made meanwhile.
+type refreshCall struct {
+	done     chan struct{}
+	err      error // why the refresh failed
+	storeErr error // why the refreshed token could not be stored
+}
+
+// New returns a Source configured with cfg.
+func New(cfg Config) (*Source, error) {
+	if cfg.Client == nil && cfg.Refresh == nil {
+		errMsg := "Must initialize the token source with a Loginradius client"
+		return nil, lrerror.New(lrerror.CodeInitialization, errMsg, errors.New(errMsg))
+	}
+	if cfg.Store != nil && cfg.Key == "" {
+		errMsg := "Must initialize the token source with the key of the token in the store"
+		return nil, lrerror.New(lrerror.CodeInitialization, errMsg, errors.New(errMsg))
+	}
+	if cfg.Store != nil {
+		if err := loadOrStore(&cfg); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Token == nil || (cfg.Token.AccessToken == "" && cfg.Token.RefreshToken == "") {
+		errMsg := "Must initialize the token source with an access token or a refresh token"
+		return nil, lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
+	}
+	if cfg.RefreshBefore <= 0 {
+		cfg.RefreshBefore = DefaultRefreshBefore
+	}
+	s := &Source{cfg: cfg, token: *cfg.Token, now: time.Now}
+	if s.cfg.Refresh == nil {
+		s.cfg.Refresh = s.refreshToken
+	}
+	return s, nil
+}
+
+// loadOrStore loads the token of cfg from its store if it is not set, or stores it.
+func loadOrStore(cfg *Config) error {
+	ctx := context.Background()
+	if cfg.Token != nil {
+		return cfg.Store.Put(ctx, cfg.Key, cfg.Token)
+	}
+	token, ok, err := cfg.Store.Get(ctx, cfg.Key)
+	if err != nil {
+		return err
+	}
+	if ok {
+		cfg.Token = token
+	}
+	return nil
+}
+
+// Token returns a valid token, refreshing it first if it expires within RefreshBefore.
+// If the refresh fails, the current token is returned as long as it has not expired.
+func (s *Source) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	token := s.token
+	fresh := token.Valid(s.now().Add(s.cfg.RefreshBefore))
+	s.mu.Unlock()
+	if fresh {
+		return &token, nil
+	}
+
+	call, err := s.refresh(ctx)
+	if err == nil {
+		err = call.err
+	}
+	s.mu.Lock()
+	token = s.token
+	s.mu.Unlock()
+	if err != nil && !token.Valid(s.now()) {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Refresh refreshes the token, whether it expires soon or not. If the refreshed token
+// cannot be stored in the Store, it is returned along with an error matching
+// lrerror.ErrTokenStore.
+func (s *Source) Refresh(ctx context.Context) (*Token, error) {
+	call, err := s.refresh(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if call.err != nil {
+		return nil, call.err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token := s.token
+	return &token, call.storeErr
+}
+
+// Client returns a copy of the Loginradius client holding a valid access token, for use
+// with the APIs requiring one.
+func (s *Source) Client(ctx context.Context) (*lr.Loginradius, error) {
+	if s.cfg.Client == nil {
+		errMsg := "Must initialize the token source with a Loginradius client"
+		return nil, lrerror.New(lrerror.CodeInitialization, errMsg, errors.New(errMsg))
+	}
+	token, err := s.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.cfg.Client.WithToken(token.AccessToken), nil
+}
+
+// Logout revokes the refresh token with GetRevokeRefreshToken and forgets the token,
+// deleting it from the Store. A refresh in flight is waited for first, so that the token
+// it rotates is the one revoked and is not stored again afterwards. The Source cannot be
+// used afterwards.
+func (s *Source) Logout(ctx context.Context) error {
+	s.mu.Lock()
+	for s.refreshing != nil {
+		call := s.refreshing
+		s.mu.Unlock()
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		s.mu.Lock()
+	}
+	refreshToken := s.token.RefreshToken
+	s.token = Token{}
+	s.mu.Unlock()
+	if s.cfg.Store != nil {
+		if err := s.cfg.Store.Delete(ctx, s.cfg.Key); err != nil {
+			return err
+		}
+	}
+	if refreshToken == "" || s.cfg.Client == nil {
+		return nil
+	}
+	_, err := lraccount.Loginradius{Client: s.cfg.Client}.GetRevokeRefreshTokenWithContext(ctx, map[string]string{"refresh_token": refreshToken})
+	return err
+}
+
+// refresh starts a refresh of the token unless one is in flight, and returns it once it
+// completes. It returns the error of ctx if ctx ends first.
+func (s *Source) refresh(ctx context.Context) (*refreshCall, error) {
+	s.mu.Lock()
+	call := s.refreshing
+	if call == nil {
+		call = &refreshCall{done: make(chan struct{})}
+		s.refreshing = call
+		go s.runRefresh(context.WithoutCancel(ctx), call, s.token)
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// runRefresh refreshes current and replaces the token with the refreshed one, then
+// completes call.
+func (s *Source) runRefresh(ctx context.Context, call *refreshCall, current Token) {
+	var refreshed *Token
+	if current.RefreshToken == "" {
+		errMsg := "Must initialize the token source with a refresh token to refresh the access token"
+		call.err = lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
+	} else {
+		refreshed, call.err = s.cfg.Refresh(ctx, &current)
+		if call.err == nil && refreshed == nil {
+			errMsg := "Refresh returned no token"
+			call.err = lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
+		}
+	}
+	ok := call.err == nil
+	if ok {
+		// Keep the refresh token if LoginRadius did not rotate it.
+		if refreshed.RefreshToken == "" {
+			refreshed.RefreshToken = current.RefreshToken
+		}
+		if s.cfg.Store != nil {
+			if err := s.cfg.Store.Put(ctx, s.cfg.Key, refreshed); err != nil {
+				call.storeErr = lrerror.New(lrerror.CodeTokenStore, "Error storing the refreshed token", err)
+				if s.cfg.Logger != nil {
+					s.cfg.Logger.WarnContext(ctx, "Refreshed token could not be stored", "key", s.cfg.Key, "error", err)
+				}
+			}
+		}
+	}
+
+	s.mu.Lock()
+	if ok {
+		s.token = *refreshed
+	}
+	s.refreshing = nil
+	s.mu.Unlock()
+
+	if ok && s.cfg.OnRefresh != nil {
+		token := *refreshed
+		s.cfg.OnRefresh(&token)
+	}
+	close(call.done)
+}
+
+// refreshToken refreshes the token with GetRefreshAccessTokenByRefreshToken.
+func (s *Source) refreshToken(ctx context.Context, token *Token) (*Token, error) {
+	res, err := lraccount.Loginradius{Client: s.cfg.Client}.GetRefreshAccessTokenByRefreshTokenWithContext(ctx, map[string]string{"refresh_token": token.RefreshToken})
+	if err != nil {
+		return nil, err
+	}
+	session, err := lrjson.DecodeAs[lrjson.AccessToken](res)
+	if err != nil {
+		return nil, err
+	}
+	if session.AccessToken == "" {
+		errMsg := "LoginRadius returned no access token"
+		return nil, lrerror.New(lrerror.CodeEncoding, "Error decoding the refreshed token", errors.New(errMsg))
+	}
+	return FromAccessToken(session), nil
+}
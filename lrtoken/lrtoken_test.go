@@ -0,0 +1,232 @@
+package lrtoken
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	lr "github.com/LoginRadius/go-sdk"
+	lrauthentication "github.com/LoginRadius/go-sdk/api/authentication"
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+// initLoginradiusStub serves the refresh and revoke APIs, rotating the refresh token on
+// every refresh, and the profile API for the latest access token.
+func initLoginradiusStub(refreshes, revokes *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.LoadInt32(refreshes)
+		switch r.URL.Path {
+		case "/identity/v2/manage/account/access_token/refresh":
+			if r.URL.Query().Get("refresh_token") != fmt.Sprintf("refresh-%d", n) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"ErrorCode": 974, "Message": "Refresh token is invalid"}`)
+				return
+			}
+			time.Sleep(20 * time.Millisecond)
+			n = atomic.AddInt32(refreshes, 1)
+			fmt.Fprintf(w, `{"access_token": "access-%d", "refresh_token": "refresh-%d", "expires_in": %q}`,
+				n, n, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+		case "/identity/v2/manage/account/access_token/refresh/revoke":
+			if r.URL.Query().Get("refresh_token") != fmt.Sprintf("refresh-%d", n) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"ErrorCode": 974, "Message": "Refresh token is invalid"}`)
+				return
+			}
+			atomic.AddInt32(revokes, 1)
+			fmt.Fprint(w, `{"IsPosted": true}`)
+		case "/identity/v2/auth/account":
+			if r.Header.Get("Authorization") != fmt.Sprintf("Bearer access-%d", n) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `{"Uid": "test-uid"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func initSource(t *testing.T, stub *httptest.Server, token *Token) *Source {
+	lrclient, _ := lr.NewLoginradius(&lr.Config{ApiKey: "abcd1234", ApiSecret: "abcd1234"})
+	lrclient.Domain = stub.URL
+	source, err := New(Config{Client: lrclient, Token: token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return source
+}
+
+func TestSourceReturnsFreshToken(t *testing.T) {
+	var refreshes, revokes int32
+	stub := initLoginradiusStub(&refreshes, &revokes)
+	defer stub.Close()
+	source := initSource(t, stub, &Token{AccessToken: "access-0", RefreshToken: "refresh-0", Expiry: time.Now().Add(time.Hour)})
+
+	token, err := source.Token(context.Background())
+	if err != nil || token.AccessToken != "access-0" || refreshes != 0 {
+		t.Errorf("Expected the fresh token to be returned without refreshing, got %+v, %v", token, err)
+	}
+}
+
+func TestSourceRefreshesAheadOfExpiry(t *testing.T) {
+	var refreshes, revokes int32
+	stub := initLoginradiusStub(&refreshes, &revokes)
+	defer stub.Close()
+	source := initSource(t, stub, &Token{AccessToken: "access-0", RefreshToken: "refresh-0", Expiry: time.Now().Add(30 * time.Second)})
+	var persisted *Token
+	source.cfg.OnRefresh = func(token *Token) { persisted = token }
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if token, err := source.Token(context.Background()); err != nil || token.AccessToken != "access-1" {
+				t.Errorf("Expected the refreshed token, got %+v, %v", token, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if refreshes != 1 {
+		t.Errorf("Expected concurrent calls to share a single refresh, got %d refreshes", refreshes)
+	}
+	if persisted == nil || persisted.RefreshToken != "refresh-1" {
+		t.Errorf("Expected OnRefresh to get the rotated refresh token, got %+v", persisted)
+	}
+
+	// The rotated refresh token is used for the next refresh.
+	if token, err := source.Refresh(context.Background()); err != nil || token.AccessToken != "access-2" {
+		t.Errorf("Expected the rotated refresh token to be used, got %+v, %v", token, err)
+	}
+}
+
+func TestSourceRefreshOutlivesCancelledCaller(t *testing.T) {
+	var refreshes, revokes int32
+	stub := initLoginradiusStub(&refreshes, &revokes)
+	defer stub.Close()
+	source := initSource(t, stub, &Token{RefreshToken: "refresh-0"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := make(chan error)
+	go func() {
+		_, err := source.Token(ctx)
+		cancelled <- err
+	}()
+	time.Sleep(5 * time.Millisecond)
+	cancel()
+	if err := <-cancelled; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the cancelled call to return its context error, got: %v", err)
+	}
+
+	// The refresh started by the cancelled call completes, and is shared by this call.
+	if token, err := source.Token(context.Background()); err != nil || token.AccessToken != "access-1" {
+		t.Errorf("Expected the refresh to complete despite the cancelled caller, got %+v, %v", token, err)
+	}
+	if refreshes != 1 {
+		t.Errorf("Expected a single refresh, got %d", refreshes)
+	}
+}
+
+func TestSourceClient(t *testing.T) {
+	var refreshes, revokes int32
+	stub := initLoginradiusStub(&refreshes, &revokes)
+	defer stub.Close()
+	source := initSource(t, stub, &Token{RefreshToken: "refresh-0"})
+
+	client, err := source.Client(context.Background())
+	if err != nil {
+		t.Fatalf("Expected a client holding the refreshed token, got: %v", err)
+	}
+	if _, err := (lrauthentication.Loginradius{Client: client}).GetAuthReadProfilesByToken(); err != nil {
+		t.Errorf("Expected the client to call APIs with the refreshed token, got: %v", err)
+	}
+	if source.cfg.Client.Context.Token != "" {
+		t.Error("Expected the configured client to be left without token")
+	}
+}
+
+func TestSourceRefreshFailure(t *testing.T) {
+	var refreshes, revokes int32
+	stub := initLoginradiusStub(&refreshes, &revokes)
+	defer stub.Close()
+
+	source := initSource(t, stub, &Token{AccessToken: "access-0", RefreshToken: "revoked", Expiry: time.Now().Add(30 * time.Second)})
+	if token, err := source.Token(context.Background()); err != nil || token.AccessToken != "access-0" {
+		t.Errorf("Expected the unexpired token to be returned when the refresh fails, got %+v, %v", token, err)
+	}
+
+	source.now = func() time.Time { return time.Now().Add(time.Minute) }
+	var apiErr *lrerror.APIError
+	if _, err := source.Token(context.Background()); !errors.As(err, &apiErr) {
+		t.Errorf("Expected the refresh error once the token has expired, got: %v", err)
+	}
+}
+
+func TestSourceLogout(t *testing.T) {
+	var refreshes, revokes int32
+	stub := initLoginradiusStub(&refreshes, &revokes)
+	defer stub.Close()
+	source := initSource(t, stub, &Token{AccessToken: "access-0", RefreshToken: "refresh-0", Expiry: time.Now().Add(time.Hour)})
+
+	if err := source.Logout(context.Background()); err != nil || revokes != 1 {
+		t.Fatalf("Expected the refresh token to be revoked, got %d revokes, %v", revokes, err)
+	}
+	if _, err := source.Token(context.Background()); !errors.Is(err, lrerror.ErrMissingToken) {
+		t.Errorf("Expected the token to be forgotten after logout, got: %v", err)
+	}
+}
+
+func TestSourceLogoutDuringRefresh(t *testing.T) {
+	var refreshes, revokes int32
+	stub := initLoginradiusStub(&refreshes, &revokes)
+	defer stub.Close()
+	lrclient, _ := lr.NewLoginradius(&lr.Config{ApiKey: "abcd1234", ApiSecret: "abcd1234"})
+	lrclient.Domain = stub.URL
+	store := NewMemoryStore()
+	ctx := context.Background()
+	source, err := New(Config{Client: lrclient, Token: &Token{RefreshToken: "refresh-0"}, Store: store, Key: "uid"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go source.Token(ctx)
+	time.Sleep(5 * time.Millisecond)
+	if err := source.Logout(ctx); err != nil || atomic.LoadInt32(&revokes) != 1 {
+		t.Fatalf("Expected the rotated refresh token to be revoked, got %d revokes, %v", revokes, err)
+	}
+	if atomic.LoadInt32(&refreshes) != 1 {
+		t.Errorf("Expected the logout to wait for the refresh in flight, got %d refreshes", refreshes)
+	}
+	if _, ok, _ := store.Get(ctx, "uid"); ok {
+		t.Error("Expected the refreshed token not to be stored again after logout")
+	}
+}
+
+func TestSourceRefreshReturningNoToken(t *testing.T) {
+	source, err := New(Config{
+		Token:   &Token{RefreshToken: "refresh-0"},
+		Refresh: func(ctx context.Context, token *Token) (*Token, error) { return nil, nil },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := source.Refresh(context.Background()); !errors.Is(err, lrerror.ErrMissingToken) {
+		t.Errorf("Expected a refresh returning no token to fail, got: %v", err)
+	}
+}
+
+func TestNewRequiresToken(t *testing.T) {
+	lrclient, _ := lr.NewLoginradius(&lr.Config{ApiKey: "abcd1234", ApiSecret: "abcd1234"})
+	if _, err := New(Config{Client: lrclient, Token: &Token{}}); !errors.Is(err, lrerror.ErrMissingToken) {
+		t.Errorf("Expected New to require a token, got: %v", err)
+	}
+	if _, err := New(Config{Token: &Token{AccessToken: "access-0"}}); !errors.Is(err, lrerror.ErrInitialization) {
+		t.Errorf("Expected New to require a client, got: %v", err)
+	}
+}
@@ -0,0 +1,213 @@
+package lrtoken
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+// A Store persists the tokens of users between runs, by a key identifying the user such
+// as its uid. The Expiry of the tokens is stored along with them.
+//
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Get returns the token stored under key, reporting whether it was found.
+	Get(ctx context.Context, key string) (*Token, bool, error)
+
+	// Put stores token under key, replacing the token stored under it if any.
+	Put(ctx context.Context, key string, token *Token) error
+
+	// Delete removes the token stored under key. Deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// MemoryStore is a Store keeping tokens in memory, e.g. for tests or for processes
+// sharing tokens between Sources.
+type MemoryStore struct {
+	mu     sync.Mutex
+	tokens map[string]Token
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{tokens: map[string]Token{}}
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(ctx context.Context, key string) (*Token, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token, ok := s.tokens[key]
+	if !ok {
+		return nil, false, nil
+	}
+	return &token, true, nil
+}
+
+// Put implements Store.
+func (s *MemoryStore) Put(ctx context.Context, key string, token *Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens[key] = *token
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tokens, key)
+	return nil
+}
+
+// fileStoreKeyInfo binds the encryption key of a FileStore to its use, so that the API
+// secret it may be derived from is not used as is.
+const fileStoreKeyInfo = "lrtoken.FileStore"
+
+// FileStore is a Store keeping tokens in a file encrypted with AES-256-GCM, for CLIs and
+// workers persisting tokens between runs. The file is rewritten on every change and is
+// only readable by its owner.
+//
+// A FileStore is safe for concurrent use within a process, but a file must not be shared
+// by several processes writing to it.
+type FileStore struct {
+	path string
+	aead cipher.AEAD
+
+	mu sync.Mutex
+}
+
+// NewFileStore returns a FileStore keeping tokens in the file at path, encrypted with a
+// key derived from secret. The secret is typically the API secret, or a key of your own
+// to keep the file readable after the API secret is rotated. The file is created on the
+// first Put.
+func NewFileStore(path, secret string) (*FileStore, error) {
+	if path == "" || secret == "" {
+		errMsg := "Must initialize the file token store with a path and a secret"
+		return nil, lrerror.New(lrerror.CodeInitialization, errMsg, errors.New(errMsg))
+	}
+	key, err := hkdf.Key(sha256.New, []byte(secret), nil, fileStoreKeyInfo, 32)
+	if err != nil {
+		return nil, lrerror.New(lrerror.CodeInitialization, "Error deriving the token store key", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, lrerror.New(lrerror.CodeInitialization, "Error deriving the token store key", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, lrerror.New(lrerror.CodeInitialization, "Error deriving the token store key", err)
+	}
+	return &FileStore{path: path, aead: aead}, nil
+}
+
+// Get implements Store.
+func (s *FileStore) Get(ctx context.Context, key string) (*Token, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tokens, err := s.read()
+	if err != nil {
+		return nil, false, err
+	}
+	token, ok := tokens[key]
+	if !ok {
+		return nil, false, nil
+	}
+	return &token, true, nil
+}
+
+// Put implements Store.
+func (s *FileStore) Put(ctx context.Context, key string, token *Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tokens, err := s.read()
+	if err != nil {
+		return err
+	}
+	tokens[key] = *token
+	return s.write(tokens)
+}
+
+// Delete implements Store.
+func (s *FileStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tokens, err := s.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := tokens[key]; !ok {
+		return nil
+	}
+	delete(tokens, key)
+	return s.write(tokens)
+}
+
+// read decrypts the tokens stored in the file. A missing file holds no tokens.
+func (s *FileStore) read() (map[string]Token, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[string]Token{}, nil
+	}
+	if err != nil {
+		return nil, lrerror.New(lrerror.CodeTokenStore, "Error reading the token store", err)
+	}
+
+	nonceSize := s.aead.NonceSize()
+	if len(data) < nonceSize {
+		errMsg := "token store file is truncated"
+		return nil, lrerror.New(lrerror.CodeTokenStore, "Error decrypting the token store", errors.New(errMsg))
+	}
+	plaintext, err := s.aead.Open(nil, data[:nonceSize], data[nonceSize:], []byte(fileStoreKeyInfo))
+	if err != nil {
+		return nil, lrerror.New(lrerror.CodeTokenStore, "Error decrypting the token store, check the secret", err)
+	}
+	tokens := map[string]Token{}
+	if err := json.Unmarshal(plaintext, &tokens); err != nil {
+		return nil, lrerror.New(lrerror.CodeTokenStore, "Error decoding the token store", err)
+	}
+	return tokens, nil
+}
+
+// write encrypts tokens into the file, replacing it atomically so that a crash cannot
+// leave it half written.
+func (s *FileStore) write(tokens map[string]Token) error {
+	plaintext, err := json.Marshal(tokens)
+	if err != nil {
+		return lrerror.New(lrerror.CodeTokenStore, "Error encoding the token store", err)
+	}
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return lrerror.New(lrerror.CodeTokenStore, "Error encrypting the token store", err)
+	}
+	data := s.aead.Seal(nonce, nonce, plaintext, []byte(fileStoreKeyInfo))
+
+	// CreateTemp creates the file with mode 0600.
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return lrerror.New(lrerror.CodeTokenStore, "Error writing the token store", err)
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), s.path)
+	}
+	if err != nil {
+		return lrerror.New(lrerror.CodeTokenStore, "Error writing the token store", err)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package lrtoken
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	lr "github.com/LoginRadius/go-sdk"
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+func testStore(t *testing.T, store Store) {
+	ctx := context.Background()
+	if _, ok, err := store.Get(ctx, "uid-a"); ok || err != nil {
+		t.Fatalf("Expected an empty store, got %v, %v", ok, err)
+	}
+
+	expiry := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err := store.Put(ctx, "uid-a", &Token{AccessToken: "access-a", RefreshToken: "refresh-a", Expiry: expiry}); err != nil {
+		t.Fatal(err)
+	}
+	store.Put(ctx, "uid-b", &Token{AccessToken: "access-b"})
+	token, ok, err := store.Get(ctx, "uid-a")
+	if err != nil || !ok || token.AccessToken != "access-a" || token.RefreshToken != "refresh-a" || !token.Expiry.Equal(expiry) {
+		t.Errorf("Expected the stored token with its expiry, got %+v, %v, %v", token, ok, err)
+	}
+
+	store.Put(ctx, "uid-a", &Token{AccessToken: "access-a2"})
+	if token, _, _ := store.Get(ctx, "uid-a"); token.AccessToken != "access-a2" {
+		t.Errorf("Expected Put to replace the stored token, got %+v", token)
+	}
+
+	if err := store.Delete(ctx, "uid-a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete(ctx, "uid-a"); err != nil {
+		t.Errorf("Expected deleting a missing key not to be an error, got: %v", err)
+	}
+	if _, ok, _ := store.Get(ctx, "uid-a"); ok {
+		t.Error("Expected the token to be deleted")
+	}
+	if token, ok, _ := store.Get(ctx, "uid-b"); !ok || token.AccessToken != "access-b" {
+		t.Errorf("Expected the other tokens to be kept, got %+v", token)
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore())
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens")
+	store, err := NewFileStore(path, "abcd1234")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStore(t, store)
+
+	info, err := os.Stat(path)
+	if err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("Expected the file to be only readable by its owner, got %v, %v", info, err)
+	}
+	data, _ := os.ReadFile(path)
+	if strings.Contains(string(data), "access-b") {
+		t.Error("Expected the tokens to be encrypted")
+	}
+
+	reopened, _ := NewFileStore(path, "abcd1234")
+	if token, ok, err := reopened.Get(context.Background(), "uid-b"); err != nil || !ok || token.AccessToken != "access-b" {
+		t.Errorf("Expected the tokens to persist across stores, got %+v, %v, %v", token, ok, err)
+	}
+	wrongKey, _ := NewFileStore(path, "wrong")
+	if _, _, err := wrongKey.Get(context.Background(), "uid-b"); !errors.Is(err, lrerror.ErrTokenStore) {
+		t.Errorf("Expected the file not to be readable with another secret, got: %v", err)
+	}
+
+	if _, err := NewFileStore(path, ""); !errors.Is(err, lrerror.ErrInitialization) {
+		t.Errorf("Expected NewFileStore to require a secret, got: %v", err)
+	}
+}
+
+func TestSourceStore(t *testing.T) {
+	var refreshes, revokes int32
+	stub := initLoginradiusStub(&refreshes, &revokes)
+	defer stub.Close()
+	lrclient, _ := lr.NewLoginradius(&lr.Config{ApiKey: "abcd1234", ApiSecret: "abcd1234"})
+	lrclient.Domain = stub.URL
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	if _, err := New(Config{Client: lrclient, Store: store, Key: "uid"}); !errors.Is(err, lrerror.ErrMissingToken) {
+		t.Errorf("Expected New to require a token when the store has none, got: %v", err)
+	}
+	if _, err := New(Config{Client: lrclient, Token: &Token{AccessToken: "access-0"}, Store: store}); !errors.Is(err, lrerror.ErrInitialization) {
+		t.Errorf("Expected New to require a key with a store, got: %v", err)
+	}
+
+	_, err := New(Config{Client: lrclient, Token: &Token{RefreshToken: "refresh-0"}, Store: store, Key: "uid"})
+	if stored, ok, _ := store.Get(ctx, "uid"); err != nil || !ok || stored.RefreshToken != "refresh-0" {
+		t.Fatalf("Expected the initial token to be stored, got %+v, %v", stored, err)
+	}
+
+	// A new run loads the token from the store and stores the refreshed one.
+	source, err := New(Config{Client: lrclient, Store: store, Key: "uid"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token, err := source.Token(ctx); err != nil || token.AccessToken != "access-1" {
+		t.Fatalf("Expected the stored token to be refreshed, got %+v, %v", token, err)
+	}
+	if stored, _, _ := store.Get(ctx, "uid"); stored.AccessToken != "access-1" || stored.RefreshToken != "refresh-1" {
+		t.Errorf("Expected the refreshed token to be stored, got %+v", stored)
+	}
+
+	if err := source.Logout(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, _ := store.Get(ctx, "uid"); ok {
+		t.Error("Expected the token to be deleted from the store on logout")
+	}
+}
+
+// failingStore is a Store failing to store tokens.
+type failingStore struct{ *MemoryStore }
+
+func (failingStore) Put(ctx context.Context, key string, token *Token) error {
+	return errors.New("disk full")
+}
+
+func TestSourceStoreFailure(t *testing.T) {
+	var refreshes, revokes int32
+	stub := initLoginradiusStub(&refreshes, &revokes)
+	defer stub.Close()
+	lrclient, _ := lr.NewLoginradius(&lr.Config{ApiKey: "abcd1234", ApiSecret: "abcd1234"})
+	lrclient.Domain = stub.URL
+	source, err := New(Config{Client: lrclient, Token: &Token{RefreshToken: "refresh-0"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	source.cfg.Store, source.cfg.Key = failingStore{NewMemoryStore()}, "uid"
+
+	token, err := source.Refresh(context.Background())
+	if !errors.Is(err, lrerror.ErrTokenStore) || token == nil || token.AccessToken != "access-1" {
+		t.Errorf("Expected the refreshed token along with the store error, got %+v, %v", token, err)
+	}
+	if token, err := source.Token(context.Background()); err != nil || token.AccessToken != "access-1" {
+		t.Errorf("Expected the refreshed token to be used, got %+v, %v", token, err)
+	}
+}
@@ -0,0 +1,304 @@
+package loginradius
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/httprutils"
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+// An Option configures a Loginradius client created with New.
+// Options are applied in the order they are passed to New.
+type Option func(*Loginradius) error
+
+// New initializes a new Loginradius struct with a Config struct and options.
+// Config struct must contain the ApiKey and ApiSecret of your Loginradius site
+// Example:
+//
+//	lrclient, err := lr.New(
+//		&lr.Config{ApiKey: os.Getenv("APIKEY"), ApiSecret: os.Getenv("APISECRET")},
+//		lr.WithTimeout(5*time.Second),
+//		lr.WithRetryPolicy(httprutils.DefaultRetryPolicy()),
+//	)
+//
+// Unlike NewLoginradius, the client gets its own httprutils.Client, so options and later
+// changes to lrclient.HTTPRClient do not affect other clients. An error is returned if
+// the Config or any of the options is invalid, or if an API family is not served over
+// HTTPS and WithAllowHTTP is not passed.
+func New(cfg *Config, opts ...Option) (*Loginradius, error) {
+	lr, err := NewLoginradius(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := *httprutils.TimeoutClient
+	lr.HTTPRClient = &httpClient
+
+	for _, opt := range opts {
+		if err := opt(lr); err != nil {
+			return nil, err
+		}
+	}
+	if err := lr.validateEndpoints(); err != nil {
+		return nil, err
+	}
+	return lr, nil
+}
+
+// WithDomain sets the domain of LoginRadius API calls, e.g. a custom domain. It must use
+// HTTPS unless WithAllowHTTP is passed before it.
+func WithDomain(domain string) Option {
+	return func(lr *Loginradius) error {
+		normalized, err := lr.validateBaseURL("domain", domain)
+		if err != nil {
+			return err
+		}
+		lr.Domain = normalized
+		return nil
+	}
+}
+
+// WithConfigURL sets the base URL of the configuration CDN serving the Get Configurations API,
+// e.g. "https://config.lrcontent.com", to which the /ciam/appinfo path of the API is appended.
+// It must use HTTPS unless WithAllowHTTP is passed before it.
+func WithConfigURL(configURL string) Option {
+	return func(lr *Loginradius) error {
+		normalized, err := lr.validateBaseURL("config URL", configURL)
+		if err != nil {
+			return err
+		}
+		if strings.HasSuffix(normalized, "/ciam/appinfo") {
+			return optionError(fmt.Sprintf("config URL must be the base URL of the configuration CDN, without the /ciam/appinfo path, got %q", configURL))
+		}
+		lr.ConfigURL = normalized
+		return nil
+	}
+}
+
+// WithHTTPClient sets the http.Client used to make API calls.
+func WithHTTPClient(client *http.Client) Option {
+	return func(lr *Loginradius) error {
+		if client == nil {
+			return optionError("HTTP client must not be nil")
+		}
+		lr.HTTPRClient.HTTPClient = client
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout of API calls. It applies to a copy of the http.Client,
+// so it must be passed after WithHTTPClient to apply to a custom http.Client.
+func WithTimeout(timeout time.Duration) Option {
+	return func(lr *Loginradius) error {
+		if timeout <= 0 {
+			return optionError(fmt.Sprintf("timeout must be positive, got %s", timeout))
+		}
+		httpClient := *lr.HTTPRClient.HTTPClient
+		httpClient.Timeout = timeout
+		lr.HTTPRClient.HTTPClient = &httpClient
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header of API calls.
+func WithUserAgent(userAgent string) Option {
+	return func(lr *Loginradius) error {
+		if strings.TrimSpace(userAgent) == "" || strings.ContainsAny(userAgent, "\r\n") {
+			return optionError(fmt.Sprintf("invalid user agent %q", userAgent))
+		}
+		lr.HTTPRClient.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithRegion sets the LoginRadius data center region, sent with every API call but those
+// to the configuration CDN in the region query parameter.
+func WithRegion(region string) Option {
+	return func(lr *Loginradius) error {
+		if region == "" || strings.ContainsAny(region, " \t\r\n/?&#") {
+			return optionError(fmt.Sprintf("invalid region %q", region))
+		}
+		lr.Region = region
+		return nil
+	}
+}
+
+// WithLogger sets the logger the client reports failed and retried API calls to, and
+// at debug level every request and response, with their secrets redacted.
+func WithLogger(logger *slog.Logger) Option {
+	return func(lr *Loginradius) error {
+		if logger == nil {
+			return optionError("logger must not be nil")
+		}
+		lr.HTTPRClient.Logger = logger
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the policy for retrying failed API calls.
+func WithRetryPolicy(policy *httprutils.RetryPolicy) Option {
+	return func(lr *Loginradius) error {
+		if policy == nil {
+			return optionError("retry policy must not be nil")
+		}
+		if err := policy.Validate(); err != nil {
+			return optionError(err.Error())
+		}
+		lr.HTTPRClient.Retry = policy
+		return nil
+	}
+}
+
+// WithMiddleware appends middleware to the chain wrapping every API call, see httprutils.Middleware.
+func WithMiddleware(middleware ...httprutils.Middleware) Option {
+	return func(lr *Loginradius) error {
+		for _, m := range middleware {
+			if m == nil {
+				return optionError("middleware must not be nil")
+			}
+		}
+		chain := make([]httprutils.Middleware, 0, len(lr.HTTPRClient.Middleware)+len(middleware))
+		chain = append(chain, lr.HTTPRClient.Middleware...)
+		lr.HTTPRClient.Middleware = append(chain, middleware...)
+		return nil
+	}
+}
+
+// WithRateLimiter sets the rate limiter of API calls, see httprutils.RateLimiter.
+// The limiter may be shared with other clients to limit their calls together.
+func WithRateLimiter(limiter *httprutils.RateLimiter) Option {
+	return func(lr *Loginradius) error {
+		if limiter == nil {
+			return optionError("rate limiter must not be nil")
+		}
+		if err := limiter.Validate(); err != nil {
+			return optionError(err.Error())
+		}
+		lr.HTTPRClient.RateLimiter = limiter
+		return nil
+	}
+}
+
+// WithCircuitBreaker sets the circuit breaker failing API calls fast while LoginRadius
+// is failing, see httprutils.CircuitBreaker.
+func WithCircuitBreaker(breaker *httprutils.CircuitBreaker) Option {
+	return func(lr *Loginradius) error {
+		if breaker == nil {
+			return optionError("circuit breaker must not be nil")
+		}
+		if err := breaker.Validate(); err != nil {
+			return optionError(err.Error())
+		}
+		lr.HTTPRClient.CircuitBreaker = breaker
+		return nil
+	}
+}
+
+// WithReadCache sets the cache of GET API call responses, see httprutils.ReadCache.
+func WithReadCache(cache *httprutils.ReadCache) Option {
+	return func(lr *Loginradius) error {
+		if cache == nil {
+			return optionError("read cache must not be nil")
+		}
+		if err := cache.Validate(); err != nil {
+			return optionError(err.Error())
+		}
+		lr.HTTPRClient.ReadCache = cache
+		return nil
+	}
+}
+
+// WithTracer sets the tracer tracing every API call in a span, see httprutils.Tracer.
+func WithTracer(tracer httprutils.Tracer) Option {
+	return func(lr *Loginradius) error {
+		if tracer == nil {
+			return optionError("tracer must not be nil")
+		}
+		lr.HTTPRClient.Tracer = tracer
+		return nil
+	}
+}
+
+// WithMetrics sets the metrics recording every API call, see httprutils.Metrics.
+func WithMetrics(metrics httprutils.Metrics) Option {
+	return func(lr *Loginradius) error {
+		if metrics == nil {
+			return optionError("metrics must not be nil")
+		}
+		lr.HTTPRClient.Metrics = metrics
+		return nil
+	}
+}
+
+// WithRejectSecretInURL makes API calls carrying the API secret in their URL fail rather
+// than being sent, see httprutils.Client.RejectSecretInURL.
+func WithRejectSecretInURL() Option {
+	return func(lr *Loginradius) error {
+		lr.HTTPRClient.RejectSecretInURL = true
+		return nil
+	}
+}
+
+// WithRequestSigning makes API calls requiring the API secret signed instead, with signatures
+// valid for expiry, see Sign. The API secret is then only sent to the endpoints listed in
+// httprutils.SecretInURLOperations, which take it in their query string.
+func WithRequestSigning(expiry time.Duration) Option {
+	return func(lr *Loginradius) error {
+		if expiry <= 0 {
+			return optionError(fmt.Sprintf("signing expiry must be positive, got %s", expiry))
+		}
+		lr.SignRequests = true
+		lr.SigningExpiry = expiry
+		return nil
+	}
+}
+
+// WithEndpointResolver sets the resolver overriding the base URL of API families,
+// e.g. lr.Endpoints{lr.IdentityAPI: "https://auth.example.com"}.
+func WithEndpointResolver(resolver EndpointResolver) Option {
+	return func(lr *Loginradius) error {
+		if resolver == nil {
+			return optionError("endpoint resolver must not be nil")
+		}
+		lr.Resolver = resolver
+		return nil
+	}
+}
+
+// WithAllowHTTP allows API families to be served over plain HTTP, e.g. by a local stub.
+// Pass it before WithDomain and WithConfigURL.
+func WithAllowHTTP() Option {
+	return func(lr *Loginradius) error {
+		lr.AllowHTTP = true
+		return nil
+	}
+}
+
+// validateBaseURL checks that rawURL is an absolute URL without query or fragment, using
+// HTTPS unless the client allows plain HTTP, and returns it without trailing slash.
+func (lr *Loginradius) validateBaseURL(name, rawURL string) (string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", optionError(fmt.Sprintf("invalid %s %q: %v", name, rawURL, err))
+	}
+	if (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return "", optionError(fmt.Sprintf("%s must be an absolute http or https URL, got %q", name, rawURL))
+	}
+	if parsed.RawQuery != "" || parsed.Fragment != "" {
+		return "", optionError(fmt.Sprintf("%s must not have a query or fragment, got %q", name, rawURL))
+	}
+	if parsed.Scheme != "https" && !lr.AllowHTTP {
+		return "", optionError(fmt.Sprintf("%s must use HTTPS, got %q; pass WithAllowHTTP first to allow plain HTTP", name, rawURL))
+	}
+	return strings.TrimRight(rawURL, "/"), nil
+}
+
+func optionError(errMsg string) error {
+	return lrerror.New(lrerror.CodeInitialization, "Invalid Loginradius client option", errors.New(errMsg))
+}
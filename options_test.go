@@ -0,0 +1,93 @@
+package loginradius
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/httprutils"
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+func TestNew(t *testing.T) {
+	custom := &http.Client{}
+	logger := slog.Default()
+	policy := httprutils.DefaultRetryPolicy()
+
+	lrclient, err := New(&config,
+		WithDomain("https://auth.example.com/"),
+		WithConfigURL("https://config.example.com"),
+		WithHTTPClient(custom),
+		WithTimeout(3*time.Second),
+		WithUserAgent("example-app/1.0"),
+		WithRegion("eu"),
+		WithLogger(logger),
+		WithRetryPolicy(policy),
+	)
+	if err != nil {
+		t.Fatalf("Expected valid options to be accepted, got: %v", err)
+	}
+
+	if lrclient.Domain != "https://auth.example.com" || lrclient.ConfigURL != "https://config.example.com" {
+		t.Errorf("Expected the domain and config URL to be set, got %s and %s", lrclient.Domain, lrclient.ConfigURL)
+	}
+	httpClient := lrclient.HTTPRClient
+	if httpClient == httprutils.TimeoutClient {
+		t.Error("Expected New to give the client its own httprutils.Client")
+	}
+	if httpClient.HTTPClient == custom || httpClient.HTTPClient.Timeout != 3*time.Second || custom.Timeout != 0 {
+		t.Errorf("Expected the timeout to apply to a copy of the custom http.Client, got %s", httpClient.HTTPClient.Timeout)
+	}
+	if httpClient.UserAgent != "example-app/1.0" || httpClient.Logger != logger || httpClient.Retry != policy {
+		t.Errorf("Expected the user agent, logger and retry policy to be set, got %+v", httpClient)
+	}
+	if req := lrclient.NewGetReq("/identity/v2/serverinfo"); req.QueryParams["region"] != "eu" {
+		t.Errorf("Expected requests to carry the region, got %v", req.QueryParams)
+	}
+	if req := lrclient.NewGetReq("/ciam/appinfo"); req.URL != "https://config.example.com/ciam/appinfo" || req.QueryParams["region"] != "" {
+		t.Errorf("Expected the configuration CDN to be called without region, got %s and %v", req.URL, req.QueryParams)
+	}
+
+	if httprutils.TimeoutClient.UserAgent != "" || httprutils.TimeoutClient.Retry != nil {
+		t.Error("Expected New to leave the shared TimeoutClient unchanged")
+	}
+}
+
+func TestNewRejectsInvalidOptions(t *testing.T) {
+	cases := map[string]Option{
+		"relative domain":      WithDomain("api.loginradius.com"),
+		"domain with query":    WithDomain("https://api.loginradius.com?apikey=abc"),
+		"ftp config URL":       WithConfigURL("ftp://config.lrcontent.com"),
+		"HTTP config URL":      WithConfigURL("http://config.lrcontent.com"),
+		"config API URL":       WithConfigURL("https://config.lrcontent.com/ciam/appinfo"),
+		"nil HTTP client":      WithHTTPClient(nil),
+		"zero timeout":         WithTimeout(0),
+		"multiline UA":         WithUserAgent("app\r\nX-Injected: 1"),
+		"empty region":         WithRegion(""),
+		"nil logger":           WithLogger(nil),
+		"nil middleware":       WithMiddleware(nil),
+		"nil tracer":           WithTracer(nil),
+		"nil metrics":          WithMetrics(nil),
+		"nil resolver":         WithEndpointResolver(nil),
+		"zero signing expiry":  WithRequestSigning(0),
+		"nil retry policy":     WithRetryPolicy(nil),
+		"invalid retry jitter": WithRetryPolicy(&httprutils.RetryPolicy{MaxAttempts: 3, Jitter: 2}),
+		"nil rate limiter":     WithRateLimiter(nil),
+		"zero rate burst":      WithRateLimiter(&httprutils.RateLimiter{Global: httprutils.RateLimit{Rate: 10}}),
+		"nil circuit breaker":  WithCircuitBreaker(nil),
+		"negative cool-down":   WithCircuitBreaker(&httprutils.CircuitBreaker{CoolDown: -time.Second}),
+		"nil read cache":       WithReadCache(nil),
+		"negative cache TTL":   WithReadCache(&httprutils.ReadCache{TTL: -time.Second}),
+	}
+	for name, opt := range cases {
+		if _, err := New(&config, opt); !errors.Is(err, lrerror.ErrInitialization) {
+			t.Errorf("Expected %s to be rejected, got: %v", name, err)
+		}
+	}
+
+	if _, err := New(&Config{}); !errors.Is(err, lrerror.ErrInitialization) {
+		t.Errorf("Expected New to require credentials, got: %v", err)
+	}
+}
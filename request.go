@@ -7,18 +7,30 @@ import (
 	"github.com/LoginRadius/go-sdk/lrerror"
 )
 
+// WithToken returns a copy of the Loginradius client using token as the user access token
+// for APIs requiring one. The copy shares the HTTP client and settings of lr, but not its
+// Context, so a single client can serve many users concurrently:
+//
+//	res, err := lrauthentication.Loginradius{Client: lrclient.WithToken(token)}.GetAuthReadProfilesByToken()
+func (lr Loginradius) WithToken(token string) *Loginradius {
+	ctx := *lr.Context
+	ctx.Token = token
+	lr.Context = &ctx
+	return &lr
+}
+
 // NewGetRequest takes a uri and query parameters, then constructs a GET request for LoginRadius API endpoints requiring access tokens
 // being passed in Authorization Bearer header
 func (lr Loginradius) NewGetReqWithToken(path string, queries ...map[string]string) (*httprutils.Request, error) {
 	if lr.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
 	request := &httprutils.Request{
 		Method: httprutils.Get,
-		URL:    lr.Domain + path,
+		URL:    lr.EndpointURL(path),
 		Headers: map[string]string{
 			"content-Type":  "application/x-www-form-urlencoded",
 			"Authorization": "Bearer " + lr.Context.Token,
@@ -34,6 +46,7 @@ func (lr Loginradius) NewGetReqWithToken(path string, queries ...map[string]stri
 		}
 	}
 
+	lr.setRegion(request, path)
 	return request, nil
 }
 
@@ -41,7 +54,7 @@ func (lr Loginradius) NewGetReqWithToken(path string, queries ...map[string]stri
 func (lr Loginradius) NewGetReq(path string, queries ...map[string]string) *httprutils.Request {
 	request := &httprutils.Request{
 		Method:      httprutils.Get,
-		URL:         lr.Domain + path,
+		URL:         lr.EndpointURL(path),
 		Headers:     httprutils.URLEncodedHeader,
 		QueryParams: map[string]string{},
 	}
@@ -50,6 +63,7 @@ func (lr Loginradius) NewGetReq(path string, queries ...map[string]string) *http
 			request.QueryParams[k] = v
 		}
 	}
+	lr.setRegion(request, path)
 	return request
 }
 
@@ -58,7 +72,7 @@ func (lr Loginradius) NewPostReqWithToken(path string, body interface{}, queries
 
 	if lr.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -69,7 +83,7 @@ func (lr Loginradius) NewPostReqWithToken(path string, body interface{}, queries
 
 	request := &httprutils.Request{
 		Method: httprutils.Post,
-		URL:    lr.Domain + path,
+		URL:    lr.EndpointURL(path),
 		Headers: map[string]string{
 			"content-Type":  "application/json",
 			"Authorization": "Bearer " + lr.Context.Token,
@@ -86,6 +100,7 @@ func (lr Loginradius) NewPostReqWithToken(path string, body interface{}, queries
 		}
 	}
 
+	lr.setRegion(request, path)
 	return request, nil
 }
 
@@ -98,7 +113,7 @@ func (lr Loginradius) NewPostReq(path string, body interface{}, queries ...map[s
 
 	request := &httprutils.Request{
 		Method: httprutils.Post,
-		URL:    lr.Domain + path,
+		URL:    lr.EndpointURL(path),
 		Headers: map[string]string{
 			"content-Type": "application/json",
 		},
@@ -113,6 +128,7 @@ func (lr Loginradius) NewPostReq(path string, body interface{}, queries ...map[s
 			request.QueryParams[k] = v
 		}
 	}
+	lr.setRegion(request, path)
 	return request, nil
 }
 
@@ -125,7 +141,7 @@ func (lr Loginradius) NewPutReq(path string, body interface{}, queries ...map[st
 
 	request := &httprutils.Request{
 		Method: httprutils.Put,
-		URL:    lr.Domain + path,
+		URL:    lr.EndpointURL(path),
 		Headers: map[string]string{
 			"content-Type": "application/json",
 		},
@@ -140,6 +156,7 @@ func (lr Loginradius) NewPutReq(path string, body interface{}, queries ...map[st
 			request.QueryParams[k] = v
 		}
 	}
+	lr.setRegion(request, path)
 	return request, nil
 }
 
@@ -148,7 +165,7 @@ func (lr Loginradius) NewPutReq(path string, body interface{}, queries ...map[st
 func (lr Loginradius) NewPutReqWithToken(path string, body interface{}, queries ...map[string]string) (*httprutils.Request, error) {
 	if lr.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -159,7 +176,7 @@ func (lr Loginradius) NewPutReqWithToken(path string, body interface{}, queries
 
 	request := &httprutils.Request{
 		Method: httprutils.Put,
-		URL:    lr.Domain + path,
+		URL:    lr.EndpointURL(path),
 		Headers: map[string]string{
 			"content-Type":  "application/json",
 			"Authorization": "Bearer " + lr.Context.Token,
@@ -175,29 +192,33 @@ func (lr Loginradius) NewPutReqWithToken(path string, body interface{}, queries
 			request.QueryParams[k] = v
 		}
 	}
+	lr.setRegion(request, path)
 	return request, nil
 }
 
 // NewDeleteReq takes a uri, body, and optional queries to construct a DELETE request for a LoginRadius POST API endpoint
 func (lr Loginradius) NewDeleteReq(path string, body ...interface{}) *httprutils.Request {
+	var request *httprutils.Request
 	if len(body) != 0 {
 		encoded, err := httprutils.EncodeBody(body[0])
 		if err != nil {
 			return nil
 		}
-		return &httprutils.Request{
+		request = &httprutils.Request{
 			Method:  httprutils.Delete,
-			URL:     lr.Domain + path,
+			URL:     lr.EndpointURL(path),
 			Headers: httprutils.URLEncodedHeader,
 			Body:    encoded,
 		}
 	} else {
-		return &httprutils.Request{
+		request = &httprutils.Request{
 			Method:  httprutils.Delete,
-			URL:     lr.Domain + path,
+			URL:     lr.EndpointURL(path),
 			Headers: httprutils.URLEncodedHeader,
 		}
 	}
+	lr.setRegion(request, path)
+	return request
 }
 
 // NewDeleteReqWithToken takes a uri and query parameters, then constructs a PUT request for LoginRadius API endpoints requiring access tokens
@@ -205,7 +226,7 @@ func (lr Loginradius) NewDeleteReq(path string, body ...interface{}) *httprutils
 func (lr Loginradius) NewDeleteReqWithToken(path string, body interface{}, queries ...map[string]string) (*httprutils.Request, error) {
 	if lr.Context.Token == "" {
 		errMsg := "Must initialize Loginradius with access token for this API call."
-		err := lrerror.New("MissingTokenErr", errMsg, errors.New(errMsg))
+		err := lrerror.New(lrerror.CodeMissingToken, errMsg, errors.New(errMsg))
 		return nil, err
 	}
 
@@ -216,7 +237,7 @@ func (lr Loginradius) NewDeleteReqWithToken(path string, body interface{}, queri
 
 	request := &httprutils.Request{
 		Method: httprutils.Delete,
-		URL:    lr.Domain + path,
+		URL:    lr.EndpointURL(path),
 		Headers: map[string]string{
 			"content-Type":  "application/json",
 			"Authorization": "Bearer " + lr.Context.Token,
@@ -233,6 +254,7 @@ func (lr Loginradius) NewDeleteReqWithToken(path string, body interface{}, queri
 		}
 	}
 
+	lr.setRegion(request, path)
 	return request, nil
 }
 
@@ -240,8 +262,32 @@ func (lr Loginradius) NewDeleteReqWithToken(path string, body interface{}, queri
 // and add LoginRadius app credentials in the request headers
 func (lr Loginradius) AddApiCredentialsToReqHeader(req *httprutils.Request) {
 	delete(req.QueryParams, "apiKey")
-	req.Headers["X-LoginRadius-ApiKey"] = lr.Context.ApiKey
-	req.Headers["X-LoginRadius-ApiSecret"] = lr.Context.ApiSecret
+	setHeader(req, "X-LoginRadius-ApiKey", lr.Context.ApiKey)
+	lr.AddApiSecretToReqHeader(req)
+}
+
+// AddApiSecretToReqHeader removes the API secret from the query parameters of a constructed request
+// and adds it in the X-LoginRadius-ApiSecret request header, keeping it out of proxy and access logs
+// If the client signs requests, the request is signed when sent instead and the secret is never sent
+func (lr Loginradius) AddApiSecretToReqHeader(req *httprutils.Request) {
+	delete(req.QueryParams, "apisecret")
+	delete(req.QueryParams, "secret")
+	if lr.SignRequests {
+		lr.signRequest(req)
+		return
+	}
+	setHeader(req, "X-LoginRadius-ApiSecret", lr.Context.ApiSecret)
+}
+
+// setHeader sets a header of a constructed request. The headers are copied first, since
+// requests are often constructed with the shared headers of the httprutils package.
+func setHeader(req *httprutils.Request, key, value string) {
+	headers := make(map[string]string, len(req.Headers)+1)
+	for k, v := range req.Headers {
+		headers[k] = v
+	}
+	headers[key] = value
+	req.Headers = headers
 }
 
 // NormalizeApiKey normalizes the apikey parameter in queries for requests to be sent to
@@ -250,3 +296,15 @@ func (lr Loginradius) NormalizeApiKey(req *httprutils.Request) {
 	delete(req.QueryParams, "apiKey")
 	req.QueryParams["apikey"] = lr.Context.ApiKey
 }
+
+// setRegion adds the region query parameter to a constructed request to path if the client
+// is configured with a Region. The configuration CDN is not regional and is not sent it.
+func (lr Loginradius) setRegion(req *httprutils.Request, path string) {
+	if lr.Region == "" || FamilyOf(path) == ConfigAPI {
+		return
+	}
+	if req.QueryParams == nil {
+		req.QueryParams = map[string]string{}
+	}
+	req.QueryParams["region"] = lr.Region
+}
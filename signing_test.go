@@ -0,0 +1,96 @@
+package loginradius
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/LoginRadius/go-sdk/lrerror"
+)
+
+func TestRequestSigning(t *testing.T) {
+	var verifyErr error
+	var secretHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		verifyErr = VerifySignature(r, config.ApiSecret, time.Now())
+		secretHeader = r.Header.Get("X-LoginRadius-ApiSecret")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	lrclient, err := New(&config, WithAllowHTTP(), WithDomain(server.URL), WithRequestSigning(time.Minute))
+	if err != nil {
+		t.Fatalf("Expected request signing to be accepted, got: %v", err)
+	}
+	req, err := lrclient.NewPutReq("/identity/v2/manage/account/abc", map[string]string{"FirstName": "Test"})
+	if err != nil {
+		t.Fatalf("Expected the request to be constructed, got: %v", err)
+	}
+	lrclient.AddApiCredentialsToReqHeader(req)
+	// Query parameters added after the credentials are still signed.
+	req.QueryParams["nullsupport"] = "true"
+
+	if _, err := lrclient.HTTPRClient.Send(*req); err != nil {
+		t.Fatalf("Expected the signed request to succeed, got: %v", err)
+	}
+	if verifyErr != nil {
+		t.Errorf("Expected the request to carry a valid signature, got: %v", verifyErr)
+	}
+	if secretHeader != "" {
+		t.Errorf("Expected the API secret not to be sent, got %q", secretHeader)
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	signer := requestSigner{secret: config.ApiSecret, expiry: time.Minute, now: func() time.Time { return now }}
+	signed := func() *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "https://api.loginradius.com/identity/v2/manage/account?email=A@example.com", nil)
+		signer.Sign(r, nil)
+		return r
+	}
+
+	if expires := signed().Header.Get(HeaderRequestExpires); expires != "2026-01-02 03:05:05" {
+		t.Errorf("Expected the request to expire in a minute, got %s", expires)
+	}
+	if err := VerifySignature(signed(), config.ApiSecret, now); err != nil {
+		t.Errorf("Expected a valid signature, got: %v", err)
+	}
+
+	cases := map[string]struct {
+		tamper func(r *http.Request)
+		now    time.Time
+		secret string
+	}{
+		"wrong secret":  {func(r *http.Request) {}, now, "other"},
+		"expired":       {func(r *http.Request) {}, now.Add(time.Minute), config.ApiSecret},
+		"changed query": {func(r *http.Request) { r.URL.RawQuery = "email=b@example.com" }, now, config.ApiSecret},
+		"later expiry":  {func(r *http.Request) { r.Header.Set(HeaderRequestExpires, "2026-01-03 00:00:00") }, now, config.ApiSecret},
+		"no digest":     {func(r *http.Request) { r.Header.Del(HeaderDigest) }, now, config.ApiSecret},
+		"secret sent":   {func(r *http.Request) { r.Header.Set("X-LoginRadius-ApiSecret", config.ApiSecret) }, now, config.ApiSecret},
+	}
+	for name, c := range cases {
+		r := signed()
+		c.tamper(r)
+		if err := VerifySignature(r, c.secret, c.now); !errors.Is(err, lrerror.ErrSignature) {
+			t.Errorf("Expected a request with %s to be rejected, got: %v", name, err)
+		}
+	}
+}
+
+func TestSignDecodesEndpoint(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	signer := requestSigner{secret: config.ApiSecret, expiry: time.Minute, now: func() time.Time { return now }}
+	r := httptest.NewRequest(http.MethodGet, "https://api.loginradius.com/identity/v2/manage/account?email=a%2Bb%40example.com", nil)
+	signer.Sign(r, nil)
+
+	// The HMAC-SHA256 of "2026-01-02 03:05:05:https://api.loginradius.com/identity/v2/manage/account?email=a+b@example.com".
+	if digest := r.Header.Get(HeaderDigest); digest != "SHA-256=rK63V6GBrVBK1pwdGtBdqbof+AOgWr2jZQSfee6fOfo=" {
+		t.Errorf("Expected the decoded endpoint to be signed, got %s", digest)
+	}
+	if err := VerifySignature(r, config.ApiSecret, now); err != nil {
+		t.Errorf("Expected a valid signature, got: %v", err)
+	}
+}